@@ -0,0 +1,144 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// Account Contacts are part of the Accounts Service.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#account-contacts
+
+// AccountContact associates a contact with an account.
+type AccountContact struct {
+	Contact  string `json:"contact,omitempty"`
+	Account  string `json:"account,omitempty"`
+	JobTitle string `json:"jobTitle,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	CreatedTimestamp string               `json:"createdTimestamp,omitempty"`
+	UpdatedTimestamp string               `json:"updatedTimestamp,omitempty"`
+	Links            *AccountContactLinks `json:"links,omitempty"`
+	ID               string               `json:"id,omitempty"`
+}
+
+// AccountContactLinks are the related resource URLs returned with an account contact association.
+type AccountContactLinks struct {
+	Account string `json:"account"`
+	Contact string `json:"contact"`
+}
+
+// AccountContactRequest is the request body used for creating or updating an account contact association.
+type AccountContactRequest struct {
+	AccountContact *AccountContact `json:"accountContact"`
+}
+
+// AccountContactResponse is the response body returned from creating, retrieving or updating
+// an account contact association.
+type AccountContactResponse struct {
+	AccountContact *AccountContact `json:"accountContact"`
+}
+
+// ListAccountContactsOptions specifies the optional parameters to AccountsService.ListContacts.
+type ListAccountContactsOptions struct {
+	ListOptions
+
+	// AccountID filters associations by account.
+	AccountID string `url:"filters[account],omitempty"`
+	// ContactID filters associations by contact.
+	ContactID string `url:"filters[contact],omitempty"`
+}
+
+// ListAccountContactsResponse is the response body returned from listing account contact associations.
+type ListAccountContactsResponse struct {
+	AccountContacts []*AccountContact `json:"accountContacts"`
+	Meta            *Meta             `json:"meta"`
+}
+
+// AddContact associates a contact with an account, optionally with a job title.
+// A contact can only be associated with a single account.
+func (s *AccountsService) AddContact(ctx context.Context, accountContact *AccountContactRequest) (*AccountContactResponse, *Response, error) {
+	u := "accountContacts"
+	req, err := s.client.NewRequest(http.MethodPost, u, accountContact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RetrieveContact retrieves an account contact association.
+func (s *AccountsService) RetrieveContact(ctx context.Context, id string) (*AccountContactResponse, *Response, error) {
+	u := "accountContacts/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateContact updates an account contact association, for example to change the job title of the contact.
+func (s *AccountsService) UpdateContact(ctx context.Context, id string, accountContact *AccountContactRequest) (*AccountContactResponse, *Response, error) {
+	u := "accountContacts/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, accountContact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RemoveContact deletes an account contact association.
+func (s *AccountsService) RemoveContact(ctx context.Context, id string) (*Response, error) {
+	u := "accountContacts/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListContacts lists account contact associations, one page at a time. Use ListAllPages to walk every page.
+func (s *AccountsService) ListContacts(ctx context.Context, opts *ListAccountContactsOptions) (*ListAccountContactsResponse, *Response, error) {
+	u, err := addOptions("accountContacts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAccountContactsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
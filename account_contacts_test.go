@@ -0,0 +1,157 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccountsService_AddContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AccountContactRequest{&AccountContact{Contact: "7", Account: "1", JobTitle: "Product Manager"}}
+
+	mux.HandleFunc("/api/3/accountContacts", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AccountContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"accountContact": {
+					"contact": "7",
+					"account": "1",
+					"jobTitle": "Product Manager",
+					"createdTimestamp": "2020-06-24T12:00:00-05:00",
+					"updatedTimestamp": "2020-06-24T12:00:00-05:00",
+					"links": {
+						"account": "https://your_base_url.api-us1.com/api/3/accountContacts/3/account",
+						"contact": "https://your_base_url.api-us1.com/api/3/accountContacts/3/contact"
+					},
+					"id": "3"
+				}
+			}`)
+	})
+
+	accountContact, _, err := c.Accounts.AddContact(ctx, input)
+	if err != nil {
+		t.Fatalf("Accounts.AddContact returned error: %v", err)
+	}
+
+	want := &AccountContactResponse{
+		AccountContact: &AccountContact{
+			Contact:          "7",
+			Account:          "1",
+			JobTitle:         "Product Manager",
+			CreatedTimestamp: "2020-06-24T12:00:00-05:00",
+			UpdatedTimestamp: "2020-06-24T12:00:00-05:00",
+			Links: &AccountContactLinks{
+				Account: "https://your_base_url.api-us1.com/api/3/accountContacts/3/account",
+				Contact: "https://your_base_url.api-us1.com/api/3/accountContacts/3/contact",
+			},
+			ID: "3",
+		},
+	}
+	if !reflect.DeepEqual(accountContact, want) {
+		t.Errorf("Accounts.AddContact returned %+v, want %+v", accountContact, want)
+	}
+}
+
+func TestAccountsService_RetrieveContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountContacts/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"accountContact": {"contact": "7", "account": "1", "jobTitle": "Product Manager", "id": "3"}}`)
+	})
+
+	accountContact, _, err := c.Accounts.RetrieveContact(ctx, "3")
+	if err != nil {
+		t.Fatalf("Accounts.RetrieveContact returned error: %v", err)
+	}
+
+	want := &AccountContactResponse{AccountContact: &AccountContact{Contact: "7", Account: "1", JobTitle: "Product Manager", ID: "3"}}
+	if !reflect.DeepEqual(accountContact, want) {
+		t.Errorf("Accounts.RetrieveContact returned %+v, want %+v", accountContact, want)
+	}
+}
+
+func TestAccountsService_UpdateContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AccountContactRequest{&AccountContact{JobTitle: "Head of Product"}}
+
+	mux.HandleFunc("/api/3/accountContacts/3", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AccountContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"accountContact": {"contact": "7", "account": "1", "jobTitle": "Head of Product", "id": "3"}}`)
+	})
+
+	accountContact, _, err := c.Accounts.UpdateContact(ctx, "3", input)
+	if err != nil {
+		t.Fatalf("Accounts.UpdateContact returned error: %v", err)
+	}
+
+	want := &AccountContactResponse{AccountContact: &AccountContact{Contact: "7", Account: "1", JobTitle: "Head of Product", ID: "3"}}
+	if !reflect.DeepEqual(accountContact, want) {
+		t.Errorf("Accounts.UpdateContact returned %+v, want %+v", accountContact, want)
+	}
+}
+
+func TestAccountsService_RemoveContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountContacts/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Accounts.RemoveContact(ctx, "3")
+	if err != nil {
+		t.Errorf("Accounts.RemoveContact returned error: %v", err)
+	}
+}
+
+func TestAccountsService_ListContacts(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountContacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[account]": "1"})
+		_, _ = fmt.Fprint(w, `{"accountContacts": [{"contact": "7", "account": "1", "jobTitle": "Product Manager", "id": "3"}], "meta": {"total": "1"}}`)
+	})
+
+	accountContacts, resp, err := c.Accounts.ListContacts(ctx, &ListAccountContactsOptions{AccountID: "1"})
+	if err != nil {
+		t.Fatalf("Accounts.ListContacts returned error: %v", err)
+	}
+
+	want := &ListAccountContactsResponse{
+		AccountContacts: []*AccountContact{{Contact: "7", Account: "1", JobTitle: "Product Manager", ID: "3"}},
+		Meta:            &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(accountContacts, want) {
+		t.Errorf("Accounts.ListContacts returned %+v, want %+v", accountContacts, want)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Accounts.ListContacts returned Total %d, want 1", resp.Total)
+	}
+}
@@ -0,0 +1,265 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Account Custom Fields are part of the Accounts Service.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#list-all-custom-fields-meta
+
+// AccountFieldValue is the value of an account custom field on an account.
+// FieldValue may be set to a string, or to a typed value such as DateValue, MultiValue or CurrencyValue
+// to get the encoding right. Currency values also need FieldCurrency.
+type AccountFieldValue struct {
+	AccountID     json.Number `json:"customerAccountId,omitempty"`
+	CustomFieldID json.Number `json:"customFieldId,omitempty"`
+	FieldValue    interface{} `json:"fieldValue"`
+	FieldCurrency string      `json:"fieldCurrency,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	CreatedTimestamp string `json:"createdTimestamp,omitempty"`
+	UpdatedTimestamp string `json:"updatedTimestamp,omitempty"`
+	ID               string `json:"id,omitempty"`
+}
+
+// MarshalJSON encodes v, sending a MultiValue as the array of options account custom fields expect.
+func (v AccountFieldValue) MarshalJSON() ([]byte, error) {
+	type accountFieldValue AccountFieldValue
+	fv := accountFieldValue(v)
+	fv.FieldValue = customObjectFieldValue(fv.FieldValue)
+	return json.Marshal(fv)
+}
+
+// DecodeValue decodes the value of v into dst, which should be a pointer to a typed value such as
+// *DateValue, *DatetimeValue, *MultiValue or *CurrencyValue, or any other type the value can be decoded to.
+func (v *AccountFieldValue) DecodeValue(dst interface{}) error {
+	return decodeFieldValue(v.FieldValue, dst)
+}
+
+// CreateAccountFieldRequest is the request body used for creating an account custom field.
+type CreateAccountFieldRequest struct {
+	Field *CustomFieldMeta `json:"accountCustomFieldMetum"`
+}
+
+// UpdateAccountFieldRequest is the request body used for updating an account custom field.
+type UpdateAccountFieldRequest struct {
+	Field *CustomFieldMeta `json:"accountCustomFieldMetum"`
+}
+
+// AccountFieldResponse is the response body returned from creating, retrieving or updating an account custom field.
+type AccountFieldResponse struct {
+	Field *CustomFieldMeta `json:"accountCustomFieldMetum"`
+}
+
+// ListAccountFieldsResponse is the response body returned from listing account custom fields.
+type ListAccountFieldsResponse struct {
+	Fields []*CustomFieldMeta `json:"accountCustomFieldMeta"`
+	Meta   *Meta              `json:"meta"`
+}
+
+// CreateField creates an account custom field.
+func (s *AccountsService) CreateField(ctx context.Context, field *CreateAccountFieldRequest) (*AccountFieldResponse, *Response, error) {
+	u := "accountCustomFieldMeta"
+	req, err := s.client.NewRequest(http.MethodPost, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RetrieveField retrieves an account custom field.
+func (s *AccountsService) RetrieveField(ctx context.Context, id string) (*AccountFieldResponse, *Response, error) {
+	u := "accountCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateField updates an account custom field.
+func (s *AccountsService) UpdateField(ctx context.Context, id string, field *UpdateAccountFieldRequest) (*AccountFieldResponse, *Response, error) {
+	u := "accountCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DeleteField deletes an account custom field.
+func (s *AccountsService) DeleteField(ctx context.Context, id string) (*Response, error) {
+	u := "accountCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListFields lists account custom fields, one page at a time. Use ListAllPages to walk every page.
+func (s *AccountsService) ListFields(ctx context.Context, opts *ListOptions) (*ListAccountFieldsResponse, *Response, error) {
+	u, err := addOptions("accountCustomFieldMeta", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAccountFieldsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CreateAccountFieldValueRequest is the request body used for setting an account custom field value.
+type CreateAccountFieldValueRequest struct {
+	FieldValue *AccountFieldValue `json:"accountCustomFieldDatum"`
+}
+
+// UpdateAccountFieldValueRequest is the request body used for updating an account custom field value.
+type UpdateAccountFieldValueRequest struct {
+	FieldValue *AccountFieldValue `json:"accountCustomFieldDatum"`
+}
+
+// AccountFieldValueResponse is the response body returned from creating, retrieving or updating
+// an account custom field value.
+type AccountFieldValueResponse struct {
+	FieldValue *AccountFieldValue `json:"accountCustomFieldDatum"`
+}
+
+// ListAccountFieldValuesOptions specifies the optional parameters to AccountsService.ListFieldValues.
+type ListAccountFieldValuesOptions struct {
+	ListOptions
+
+	// AccountID filters values by the account they belong to.
+	AccountID string `url:"filters[customerAccountId],omitempty"`
+}
+
+// ListAccountFieldValuesResponse is the response body returned from listing account custom field values.
+type ListAccountFieldValuesResponse struct {
+	FieldValues []*AccountFieldValue `json:"accountCustomFieldData"`
+	Meta        *Meta                `json:"meta"`
+}
+
+// CreateFieldValue sets the value of an account custom field on an account.
+func (s *AccountsService) CreateFieldValue(ctx context.Context, fieldValue *CreateAccountFieldValueRequest) (*AccountFieldValueResponse, *Response, error) {
+	u := "accountCustomFieldData"
+	req, err := s.client.NewRequest(http.MethodPost, u, fieldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RetrieveFieldValue retrieves an account custom field value.
+func (s *AccountsService) RetrieveFieldValue(ctx context.Context, id string) (*AccountFieldValueResponse, *Response, error) {
+	u := "accountCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateFieldValue updates an account custom field value.
+func (s *AccountsService) UpdateFieldValue(ctx context.Context, id string, fieldValue *UpdateAccountFieldValueRequest) (*AccountFieldValueResponse, *Response, error) {
+	u := "accountCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, fieldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DeleteFieldValue deletes an account custom field value.
+func (s *AccountsService) DeleteFieldValue(ctx context.Context, id string) (*Response, error) {
+	u := "accountCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListFieldValues lists account custom field values, one page at a time. Use ListAllPages to walk every page.
+func (s *AccountsService) ListFieldValues(ctx context.Context, opts *ListAccountFieldValuesOptions) (*ListAccountFieldValuesResponse, *Response, error) {
+	u, err := addOptions("accountCustomFieldData", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAccountFieldValuesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
@@ -0,0 +1,254 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccountsService_CreateField(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateAccountFieldRequest{
+		&CustomFieldMeta{
+			FieldLabel:   "Industry",
+			FieldType:    FieldTypeDropdown,
+			FieldOptions: []string{"Retail", "Software"},
+			IsRequired:   "0",
+		},
+	}
+
+	mux.HandleFunc("/api/3/accountCustomFieldMeta", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateAccountFieldRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldMetum": {"fieldLabel": "Industry", "fieldType": "dropdown", "fieldOptions": ["Retail", "Software"], "isRequired": 0, "id": "9"}}`)
+	})
+
+	field, _, err := c.Accounts.CreateField(ctx, input)
+	if err != nil {
+		t.Fatalf("Accounts.CreateField returned error: %v", err)
+	}
+
+	want := &AccountFieldResponse{
+		Field: &CustomFieldMeta{FieldLabel: "Industry", FieldType: FieldTypeDropdown, FieldOptions: []string{"Retail", "Software"}, IsRequired: "0", ID: "9"},
+	}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Accounts.CreateField returned %+v, want %+v", field, want)
+	}
+}
+
+func TestAccountsService_RetrieveField(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldMeta/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldMetum": {"fieldLabel": "Industry", "id": "9"}}`)
+	})
+
+	field, _, err := c.Accounts.RetrieveField(ctx, "9")
+	if err != nil {
+		t.Fatalf("Accounts.RetrieveField returned error: %v", err)
+	}
+
+	want := &AccountFieldResponse{Field: &CustomFieldMeta{FieldLabel: "Industry", ID: "9"}}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Accounts.RetrieveField returned %+v, want %+v", field, want)
+	}
+}
+
+func TestAccountsService_UpdateField(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldMeta/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldMetum": {"fieldLabel": "Sector", "id": "9"}}`)
+	})
+
+	field, _, err := c.Accounts.UpdateField(ctx, "9", &UpdateAccountFieldRequest{&CustomFieldMeta{FieldLabel: "Sector"}})
+	if err != nil {
+		t.Fatalf("Accounts.UpdateField returned error: %v", err)
+	}
+
+	want := &AccountFieldResponse{Field: &CustomFieldMeta{FieldLabel: "Sector", ID: "9"}}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Accounts.UpdateField returned %+v, want %+v", field, want)
+	}
+}
+
+func TestAccountsService_DeleteField(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldMeta/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Accounts.DeleteField(ctx, "9")
+	if err != nil {
+		t.Errorf("Accounts.DeleteField returned error: %v", err)
+	}
+}
+
+func TestAccountsService_ListFields(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldMeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldMeta": [{"fieldLabel": "Industry", "id": "9"}], "meta": {"total": 1}}`)
+	})
+
+	fields, resp, err := c.Accounts.ListFields(ctx, nil)
+	if err != nil {
+		t.Fatalf("Accounts.ListFields returned error: %v", err)
+	}
+
+	want := &ListAccountFieldsResponse{
+		Fields: []*CustomFieldMeta{{FieldLabel: "Industry", ID: "9"}},
+		Meta:   &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Accounts.ListFields returned %+v, want %+v", fields, want)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Accounts.ListFields returned Total %d, want 1", resp.Total)
+	}
+}
+
+func TestAccountsService_CreateFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldData", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		want := `{"accountCustomFieldDatum":{"customerAccountId":1,"customFieldId":10,"fieldValue":["EU","US"]}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldDatum": {"customerAccountId": 1, "customFieldId": 10, "fieldValue": ["EU", "US"], "id": "20"}}`)
+	})
+
+	input := &CreateAccountFieldValueRequest{
+		&AccountFieldValue{AccountID: "1", CustomFieldID: "10", FieldValue: MultiValue{"EU", "US"}},
+	}
+	fieldValue, _, err := c.Accounts.CreateFieldValue(ctx, input)
+	if err != nil {
+		t.Fatalf("Accounts.CreateFieldValue returned error: %v", err)
+	}
+
+	var regions MultiValue
+	if err := fieldValue.FieldValue.DecodeValue(&regions); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if want := (MultiValue{"EU", "US"}); !reflect.DeepEqual(regions, want) {
+		t.Errorf("Accounts.CreateFieldValue value = %v, want %v", regions, want)
+	}
+	if fieldValue.FieldValue.ID != "20" {
+		t.Errorf("Accounts.CreateFieldValue returned value %s, want 20", fieldValue.FieldValue.ID)
+	}
+}
+
+func TestAccountsService_RetrieveFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldData/20", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldDatum": {"customerAccountId": 1, "customFieldId": 9, "fieldValue": "Retail", "id": "20"}}`)
+	})
+
+	fieldValue, _, err := c.Accounts.RetrieveFieldValue(ctx, "20")
+	if err != nil {
+		t.Fatalf("Accounts.RetrieveFieldValue returned error: %v", err)
+	}
+
+	want := &AccountFieldValueResponse{FieldValue: &AccountFieldValue{AccountID: "1", CustomFieldID: "9", FieldValue: "Retail", ID: "20"}}
+	if !reflect.DeepEqual(fieldValue, want) {
+		t.Errorf("Accounts.RetrieveFieldValue returned %+v, want %+v", fieldValue, want)
+	}
+}
+
+func TestAccountsService_UpdateFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldData/21", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "PUT")
+		want := `{"accountCustomFieldDatum":{"fieldValue":250000,"fieldCurrency":"usd"}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldDatum": {"fieldValue": 250000, "fieldCurrency": "usd", "id": "21"}}`)
+	})
+
+	input := &UpdateAccountFieldValueRequest{&AccountFieldValue{FieldValue: CurrencyValue(250000), FieldCurrency: "usd"}}
+	fieldValue, _, err := c.Accounts.UpdateFieldValue(ctx, "21", input)
+	if err != nil {
+		t.Fatalf("Accounts.UpdateFieldValue returned error: %v", err)
+	}
+
+	var revenue CurrencyValue
+	if err := fieldValue.FieldValue.DecodeValue(&revenue); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if revenue != 250000 {
+		t.Errorf("Accounts.UpdateFieldValue value = %v, want 2500.00", revenue)
+	}
+}
+
+func TestAccountsService_DeleteFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldData/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Accounts.DeleteFieldValue(ctx, "21")
+	if err != nil {
+		t.Errorf("Accounts.DeleteFieldValue returned error: %v", err)
+	}
+}
+
+func TestAccountsService_ListFieldValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accountCustomFieldData", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[customerAccountId]": "1"})
+		_, _ = fmt.Fprint(w, `{"accountCustomFieldData": [{"customerAccountId": 1, "customFieldId": 9, "fieldValue": "Retail", "id": "20"}]}`)
+	})
+
+	fieldValues, _, err := c.Accounts.ListFieldValues(ctx, &ListAccountFieldValuesOptions{AccountID: "1"})
+	if err != nil {
+		t.Fatalf("Accounts.ListFieldValues returned error: %v", err)
+	}
+
+	want := &ListAccountFieldValuesResponse{
+		FieldValues: []*AccountFieldValue{{AccountID: "1", CustomFieldID: "9", FieldValue: "Retail", ID: "20"}},
+	}
+	if !reflect.DeepEqual(fieldValues, want) {
+		t.Errorf("Accounts.ListFieldValues returned %+v, want %+v", fieldValues, want)
+	}
+}
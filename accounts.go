@@ -0,0 +1,203 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// AccountsService handles communication with account related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#accounts
+type AccountsService service
+
+// Account is a company that contacts and deals can be associated with.
+type Account struct {
+	Name       string `json:"name,omitempty"`
+	AccountURL string `json:"accountUrl,omitempty"`
+	Owner      string `json:"owner,omitempty"`
+
+	// Fields sets account custom field values when creating or updating an account.
+	Fields []*AccountFieldValue `json:"fields,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	ContactCount     string        `json:"contactCount,omitempty"`
+	DealCount        string        `json:"dealCount,omitempty"`
+	CreatedTimestamp string        `json:"createdTimestamp,omitempty"`
+	UpdatedTimestamp string        `json:"updatedTimestamp,omitempty"`
+	Links            *AccountLinks `json:"links,omitempty"`
+	ID               string        `json:"id,omitempty"`
+}
+
+// AccountLinks are the related resource URLs returned with an account.
+type AccountLinks struct {
+	Notes                  string `json:"notes"`
+	AccountCustomFieldData string `json:"accountCustomFieldData"`
+	AccountContacts        string `json:"accountContacts"`
+}
+
+// CreateAccountRequest is the request body used for creating an account.
+type CreateAccountRequest struct {
+	Account *Account `json:"account"`
+}
+
+// UpdateAccountRequest is the request body used for updating an account.
+type UpdateAccountRequest struct {
+	Account *Account `json:"account"`
+}
+
+// AccountResponse is the response body returned from creating, retrieving or updating an account.
+type AccountResponse struct {
+	Account *Account `json:"account"`
+}
+
+// ListAccountsOptions specifies the optional parameters to AccountsService.List.
+type ListAccountsOptions struct {
+	ListOptions
+
+	// Search filters accounts whose name contains the given value.
+	Search string `url:"search,omitempty"`
+	// CountDeals includes the number of deals of each account in DealCount.
+	CountDeals bool `url:"count_deals,omitempty"`
+}
+
+// ListAccountsResponse is the response body returned from listing accounts.
+type ListAccountsResponse struct {
+	Accounts []*Account `json:"accounts"`
+	Meta     *Meta      `json:"meta"`
+}
+
+// Create an account.
+func (s *AccountsService) Create(ctx context.Context, account *CreateAccountRequest) (*AccountResponse, *Response, error) {
+	u := "accounts"
+	req, err := s.client.NewRequest(http.MethodPost, u, account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve an account.
+func (s *AccountsService) Retrieve(ctx context.Context, id string) (*AccountResponse, *Response, error) {
+	u := "accounts/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update an account.
+func (s *AccountsService) Update(ctx context.Context, id string, account *UpdateAccountRequest) (*AccountResponse, *Response, error) {
+	u := "accounts/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &AccountResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete an account.
+func (s *AccountsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "accounts/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// BulkDelete deletes many accounts in a single request.
+// If ids is empty, nothing is deleted and a nil Response is returned.
+func (s *AccountsService) BulkDelete(ctx context.Context, ids []string) (*Response, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	opts := &struct {
+		IDs []string `url:"ids[]"`
+	}{ids}
+	u, err := addOptions("accounts/bulk_delete", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List accounts, one page at a time. Use ListAllPages to walk every page.
+func (s *AccountsService) List(ctx context.Context, opts *ListAccountsOptions) (*ListAccountsResponse, *Response, error) {
+	u, err := addOptions("accounts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAccountsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// FindByName returns the account whose name matches the given one case-insensitively, or nil if there is none.
+func (s *AccountsService) FindByName(ctx context.Context, name string) (*Account, error) {
+	var found *Account
+	opts := &ListAccountsOptions{ListOptions: ListOptions{Limit: 100}, Search: name}
+	err := ListAllPages(ctx, &opts.ListOptions, func(*ListOptions) (*Response, error) {
+		accounts, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, a := range accounts.Accounts {
+			if strings.EqualFold(a.Name, name) {
+				found = a
+				return nil, nil
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
@@ -0,0 +1,221 @@
+package active_campaign
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccountsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		want := `{"account":{"name":"Example Inc.","accountUrl":"https://www.example.com","owner":"1","fields":[{"customFieldId":9,"fieldValue":"500-1000"}]}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"account": {
+					"name": "Example Inc.",
+					"accountUrl": "https://www.example.com",
+					"owner": "1",
+					"createdTimestamp": "2020-06-24T12:00:00-05:00",
+					"updatedTimestamp": "2020-06-24T12:00:00-05:00",
+					"links": {
+						"notes": "https://your_base_url.api-us1.com/api/3/accounts/1/notes",
+						"accountCustomFieldData": "https://your_base_url.api-us1.com/api/3/accounts/1/accountCustomFieldData",
+						"accountContacts": "https://your_base_url.api-us1.com/api/3/accounts/1/accountContacts"
+					},
+					"id": "1"
+				}
+			}`)
+	})
+
+	input := &CreateAccountRequest{
+		&Account{
+			Name:       "Example Inc.",
+			AccountURL: "https://www.example.com",
+			Owner:      "1",
+			Fields:     []*AccountFieldValue{{CustomFieldID: "9", FieldValue: "500-1000"}},
+		},
+	}
+	account, _, err := c.Accounts.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Accounts.Create returned error: %v", err)
+	}
+
+	want := &AccountResponse{
+		Account: &Account{
+			Name:             "Example Inc.",
+			AccountURL:       "https://www.example.com",
+			Owner:            "1",
+			CreatedTimestamp: "2020-06-24T12:00:00-05:00",
+			UpdatedTimestamp: "2020-06-24T12:00:00-05:00",
+			Links: &AccountLinks{
+				Notes:                  "https://your_base_url.api-us1.com/api/3/accounts/1/notes",
+				AccountCustomFieldData: "https://your_base_url.api-us1.com/api/3/accounts/1/accountCustomFieldData",
+				AccountContacts:        "https://your_base_url.api-us1.com/api/3/accounts/1/accountContacts",
+			},
+			ID: "1",
+		},
+	}
+	if !reflect.DeepEqual(account, want) {
+		t.Errorf("Accounts.Create returned %+v, want %+v", account, want)
+	}
+}
+
+func TestAccountsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"account": {"name": "Example Inc.", "contactCount": "2", "id": "1"}}`)
+	})
+
+	account, _, err := c.Accounts.Retrieve(ctx, "1")
+	if err != nil {
+		t.Fatalf("Accounts.Retrieve returned error: %v", err)
+	}
+
+	want := &AccountResponse{Account: &Account{Name: "Example Inc.", ContactCount: "2", ID: "1"}}
+	if !reflect.DeepEqual(account, want) {
+		t.Errorf("Accounts.Retrieve returned %+v, want %+v", account, want)
+	}
+}
+
+func TestAccountsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts/1", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "PUT")
+		want := `{"account":{"name":"Example Corp."}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"account": {"name": "Example Corp.", "id": "1"}}`)
+	})
+
+	account, _, err := c.Accounts.Update(ctx, "1", &UpdateAccountRequest{&Account{Name: "Example Corp."}})
+	if err != nil {
+		t.Fatalf("Accounts.Update returned error: %v", err)
+	}
+
+	want := &AccountResponse{Account: &Account{Name: "Example Corp.", ID: "1"}}
+	if !reflect.DeepEqual(account, want) {
+		t.Errorf("Accounts.Update returned %+v, want %+v", account, want)
+	}
+}
+
+func TestAccountsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Accounts.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Accounts.Delete returned error: %v", err)
+	}
+}
+
+func TestAccountsService_BulkDelete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts/bulk_delete", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got, want := r.URL.Query()["ids[]"], []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Request ids = %v, want %v", got, want)
+		}
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Accounts.BulkDelete(ctx, []string{"1", "2", "3"})
+	if err != nil {
+		t.Errorf("Accounts.BulkDelete returned error: %v", err)
+	}
+}
+
+func TestAccountsService_BulkDelete_noIDs(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts/bulk_delete", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Accounts.BulkDelete sent a request for no IDs")
+	})
+
+	resp, err := c.Accounts.BulkDelete(ctx, nil)
+	if err != nil || resp != nil {
+		t.Errorf("Accounts.BulkDelete returned %v, %v, want nil, nil", resp, err)
+	}
+}
+
+func TestAccountsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"search": "Example", "count_deals": "1"})
+		_, _ = fmt.Fprint(w, `{"accounts": [{"name": "Example Inc.", "dealCount": "4", "id": "1"}], "meta": {"total": "1"}}`)
+	})
+
+	accounts, resp, err := c.Accounts.List(ctx, &ListAccountsOptions{Search: "Example", CountDeals: true})
+	if err != nil {
+		t.Fatalf("Accounts.List returned error: %v", err)
+	}
+
+	want := &ListAccountsResponse{
+		Accounts: []*Account{{Name: "Example Inc.", DealCount: "4", ID: "1"}},
+		Meta:     &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(accounts, want) {
+		t.Errorf("Accounts.List returned %+v, want %+v", accounts, want)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Accounts.List returned Total %d, want 1", resp.Total)
+	}
+}
+
+func TestAccountsService_FindByName(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/accounts", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"search": "example inc.", "limit": "100"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"accounts": [
+					{"name": "Example Inc. (EU)", "id": "2"},
+					{"name": "Example Inc.", "id": "1"}
+				],
+				"meta": {"total": "2"}
+			}`)
+	})
+
+	account, err := c.Accounts.FindByName(ctx, "example inc.")
+	if err != nil {
+		t.Fatalf("Accounts.FindByName returned error: %v", err)
+	}
+	if account == nil || account.ID != "1" {
+		t.Errorf("Accounts.FindByName returned %+v, want account 1", account)
+	}
+}
@@ -2,12 +2,18 @@ package active_campaign
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // httpClient defines an interface for an http.Client implementation so that alternative
@@ -22,6 +28,8 @@ const (
 	headerContentType = "Content-Type"
 )
 
+var errNonNilContext = errors.New("context must be non-nil")
+
 // A Client manages communication with the Active Campaign API.
 type Client struct {
 	// HTTP client used to communicate with the API.
@@ -33,11 +41,34 @@ type Client struct {
 	// Token for API requests.
 	token string
 
+	// Policy used to retry rate limited and failed requests. Nil disables retries.
+	retry *RetryPolicy
+
+	// Limiter shared by all services to throttle outgoing requests. Nil disables throttling.
+	limiter *rateLimiter
+
+	// IDs of contacts and tags resolved by email or name.
+	cache *idCache
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Active Campaign API.
-	Contacts *ContactsService
-	Tags     *TagsService
+	Accounts    *AccountsService
+	Automations *AutomationsService
+	Campaigns   *CampaignsService
+	Connections *ConnectionsService
+	Contacts    *ContactsService
+	Deals       *DealsService
+	DealFields  *DealFieldsService
+	Fields      *FieldsService
+	Lists       *ListsService
+	Messages    *MessagesService
+	Pipelines   *PipelinesService
+	Stages      *StagesService
+	Tags        *TagsService
+
+	// FieldRegistry resolves custom fields by personalization tag or title.
+	FieldRegistry *FieldRegistry
 }
 
 type service struct {
@@ -49,6 +80,16 @@ type ClientOpts struct {
 	HttpClient httpClient
 	BaseUrl    string
 	Token      string
+
+	// Retry optionally enables retrying of rate limited and failed requests. See RetryPolicy.
+	Retry *RetryPolicy
+
+	// RequestsPerSecond is the client-side budget for outgoing requests, shared by all services.
+	// Zero uses DefaultRequestsPerSecond. A negative value disables client-side rate limiting.
+	RequestsPerSecond float64
+
+	// FieldCacheTTL is how long the FieldRegistry caches field definitions. Zero uses DefaultFieldCacheTTL.
+	FieldCacheTTL time.Duration
 }
 
 // NewClient returns a new Active Campaign API client. httpClient is provided to allow a
@@ -77,16 +118,42 @@ func NewClient(opts *ClientOpts) (*Client, error) {
 		client:  httpClient,
 		baseURL: parsedBaseURL,
 		token:   opts.Token,
+		retry:   opts.Retry,
+		limiter: newRateLimiter(opts.RequestsPerSecond),
+		cache:   newIDCache(),
 	}
 	c.common.client = c
+	c.Accounts = (*AccountsService)(&c.common)
+	c.Automations = (*AutomationsService)(&c.common)
+	c.Campaigns = (*CampaignsService)(&c.common)
+	c.Connections = (*ConnectionsService)(&c.common)
 	c.Contacts = (*ContactsService)(&c.common)
+	c.Deals = (*DealsService)(&c.common)
+	c.DealFields = (*DealFieldsService)(&c.common)
+	c.Fields = (*FieldsService)(&c.common)
+	c.Lists = (*ListsService)(&c.common)
+	c.Messages = (*MessagesService)(&c.common)
+	c.Pipelines = (*PipelinesService)(&c.common)
+	c.Stages = (*StagesService)(&c.common)
 	c.Tags = (*TagsService)(&c.common)
+	c.FieldRegistry = newFieldRegistry(c, opts.FieldCacheTTL)
 	return c, nil
 }
 
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
+//
+// NewRequest wraps NewRequestWithContext using context.Background.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request bound to ctx. A relative URL can be provided in urlStr,
+// in which case it is resolved relative to the BaseURL of the Client.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
 	u, err := c.baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -103,7 +170,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -115,12 +182,34 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// defaultPageLimit is the number of items Active Campaign returns per page when no limit is requested.
+const defaultPageLimit = 20
+
+// maxPageLimit is the largest number of items Active Campaign returns per page, whatever limit is requested.
+const maxPageLimit = 100
+
+// ListOptions specifies the optional parameters to various List methods that support offset pagination.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#pagination
+type ListOptions struct {
+	// Limit is the number of items to return per page. Active Campaign defaults to 20 and allows at most 100.
+	Limit int `url:"limit,omitempty"`
+
+	// Offset is the number of items to skip before the first item returned.
+	Offset int `url:"offset,omitempty"`
+}
+
 // Response is a Active Campaign API response. This wraps the standard http.Response
 // returned from Active Campaign.
 type Response struct {
 	*http.Response
 
-	// Future additional fields will go here.
+	// Total is the total number of items available to a list request, as reported by the "meta" object of the response.
+	// It is zero for responses that are not paginated.
+	Total int
+
+	// NextOffset is the offset of the next page of a list request, or zero if this is the last page.
+	NextOffset int
 }
 
 func newResponse(r *http.Response) *Response {
@@ -128,11 +217,97 @@ func newResponse(r *http.Response) *Response {
 	return resp
 }
 
+// populatePageValues sets Total and NextOffset from the "meta" object of a list response body,
+// using the offset and limit of the request that produced it.
+func (r *Response) populatePageValues(req *http.Request, body []byte) {
+	var page struct {
+		Meta *struct {
+			Total json.Number `json:"total"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil || page.Meta == nil {
+		return
+	}
+	total, err := strconv.Atoi(page.Meta.Total.String())
+	if err != nil {
+		return
+	}
+	r.Total = total
+
+	q := req.URL.Query()
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if next := offset + limit; next < total {
+		r.NextOffset = next
+	}
+}
+
+// ListAllPages calls list once for every page of a paginated endpoint, starting at opts.Offset, until the
+// last page has been fetched, list returns an error, or ctx is done. opts is updated in place before each call,
+// so list should pass it to the List method it wraps. A nil opts starts at the first page with the default limit.
+// list may return a nil *Response and nil error to stop early, for example once it has found what it was looking for.
+//
+//	var tags []*CreatedTag
+//	err := ac.ListAllPages(ctx, nil, func(opts *ac.ListOptions) (*ac.Response, error) {
+//		page, resp, err := client.Tags.ListAll(ctx, opts)
+//		if err != nil {
+//			return resp, err
+//		}
+//		tags = append(tags, page.Tags...)
+//		return resp, nil
+//	})
+func ListAllPages(ctx context.Context, opts *ListOptions, list func(opts *ListOptions) (*Response, error)) error {
+	if ctx == nil {
+		return errNonNilContext
+	}
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resp, err := list(opts)
+		if err != nil {
+			return err
+		}
+		if resp == nil || resp.NextOffset == 0 {
+			return nil
+		}
+		opts.Offset = resp.NextOffset
+	}
+}
+
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
-func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+// If v implements the io.Writer interface, the raw response body will be written to v, without attempting to first decode it.
+//
+// The provided ctx must be non-nil and is attached to req. If ctx is canceled or its deadline is exceeded
+// before a response is received, ctx.Err() is returned so callers can compare against
+// context.Canceled and context.DeadlineExceeded.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+	req = req.WithContext(ctx)
+
+	httpResp, err := c.send(ctx, req)
 	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
 		return nil, err
 	}
 
@@ -143,39 +318,277 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		return newResponse(httpResp), err
 	}
 
-	if v != nil {
-		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
-		defer func() { _ = httpResp.Body.Close() }()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+	resp := newResponse(httpResp)
+	if v == nil {
+		return resp, nil
 	}
 
-	resp := newResponse(httpResp)
+	// Close the reader only if there is a provided interface to decode to
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if w, ok := v.(io.Writer); ok {
+		_, err = io.Copy(w, httpResp.Body)
+		return resp, err
+	}
+
+	data, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		// ignore empty response bodies
+		return resp, nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return resp, err
+	}
+	resp.populatePageValues(req, data)
+
+	return resp, nil
+}
+
+// doNoContent sends an API request whose response body is not needed, such as a delete. The body is drained
+// and closed so that the underlying connection can be reused.
+func (c *Client) doNoContent(ctx context.Context, req *http.Request) (*Response, error) {
+	resp, err := c.Do(ctx, req, nil)
+	if resp != nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	return resp, err
+}
+
+// unmarshalStatus decodes a numeric status code, sent by Active Campaign as a string, a number or an empty
+// string, into dst. A JSON null leaves dst unchanged.
+func unmarshalStatus(data []byte, dst *string) error {
+	switch string(data) {
+	case "null":
+		return nil
+	case `""`:
+		*dst = ""
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*dst = n.String()
+	return nil
+}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, _ = io.Copy(w, resp.Body)
-		} else {
-			decErr := json.NewDecoder(resp.Body).Decode(v)
-			if decErr == io.EOF {
-				decErr = nil // ignore EOF errors caused by empty response body
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct whose fields contain "url" tags, or a pointer to one.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	if err := encodeQuery(qs, v); err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// encodeQuery adds the "url" tagged fields of the struct v to values. Fields tagged with
+// "omitempty" are skipped when they hold their zero value. Embedded structs are flattened.
+func encodeQuery(values url.Values, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("query options must be a struct, got %v", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+
+		tag := sf.Tag.Get("url")
+		if tag == "-" || sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		if tag == "" {
+			if sf.Anonymous {
+				if err := encodeQuery(values, fv); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		name, omitEmpty := tag, false
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name, omitEmpty = tag[:idx], tag[idx+1:] == "omitempty"
+		}
+
+		for fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				break
 			}
-			if decErr != nil {
-				err = decErr
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Ptr || omitEmpty && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				values.Add(name, queryValue(fv.Index(j)))
 			}
+			continue
+		}
+		values.Add(name, queryValue(fv))
+	}
+	return nil
+}
+
+// queryValue formats a single query parameter value.
+func queryValue(v reflect.Value) string {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return "1"
 		}
+		return "0"
+	case reflect.String:
+		// Use the raw value so that string types with a String method, like DealStatus, encode as sent by the API.
+		return v.String()
 	}
+	return fmt.Sprint(v.Interface())
+}
 
-	return resp, err
+// ErrorResponse reports one or more errors caused by an API request.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#errors
+type ErrorResponse struct {
+	Response *http.Response // HTTP response that caused this error
+
+	// Errors is populated from the "errors" array Active Campaign returns on 4xx and 422 responses.
+	Errors []Error `json:"errors"`
+
+	// Message is populated by endpoints that return a single {"message": "..."} body instead of an errors array.
+	Message string `json:"message"`
+}
+
+// Error describes a single error returned by the Active Campaign API.
+type Error struct {
+	Title  string       `json:"title"`
+	Detail string       `json:"detail,omitempty"`
+	Code   string       `json:"code,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource points at the part of the request document that caused an Error.
+type ErrorSource struct {
+	Pointer string `json:"pointer"`
+}
+
+func (e Error) Error() string {
+	if e.Detail != "" {
+		return e.Title + ": " + e.Detail
+	}
+	return e.Title
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Message
+	if len(r.Errors) > 0 {
+		titles := make([]string, len(r.Errors))
+		for i, e := range r.Errors {
+			titles[i] = e.Error()
+		}
+		msg = strings.Join(titles, "; ")
+	}
+
+	if r.Response == nil {
+		return msg
+	}
+	if r.Response.Request == nil {
+		return fmt.Sprintf("%d %v", r.Response.StatusCode, msg)
+	}
+	return fmt.Sprintf("%v %v: %d %v",
+		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, msg)
+}
+
+// hasCode reports whether any of the errors in r carry the given code.
+func (r *ErrorResponse) hasCode(code string) bool {
+	for _, e := range r.Errors {
+		if e.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// statusCode returns the status code of the response that caused err, or 0 if err is not an *ErrorResponse.
+func statusCode(err error) int {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return 0
+	}
+	return errResp.Response.StatusCode
+}
+
+// IsNotFound reports whether err is an *ErrorResponse caused by a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an *ErrorResponse caused by a 429 Too Many Requests response.
+func IsRateLimited(err error) bool {
+	return statusCode(err) == http.StatusTooManyRequests
+}
+
+// IsValidation reports whether err is an *ErrorResponse caused by a 422 Unprocessable Entity response.
+func IsValidation(err error) bool {
+	return statusCode(err) == http.StatusUnprocessableEntity
+}
+
+// IsDuplicate reports whether err is an *ErrorResponse caused by creating a resource that already exists.
+func IsDuplicate(err error) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+	return errResp.hasCode("duplicate")
 }
 
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
-// The caller is responsible to analyze the response body.
+// API error responses are expected to have response body, and a JSON response body that maps to ErrorResponse.
+// Any other response body will be silently ignored.
+//
+// The response body is restored after it is read, so the caller may still inspect it.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
 	}
 
-	err := fmt.Errorf("Request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
-	return err
+	errorResponse := &ErrorResponse{Response: r}
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err == nil && data != nil {
+			_ = json.Unmarshal(data, errorResponse)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+	return errorResponse
 }
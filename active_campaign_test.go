@@ -1,50 +1,93 @@
 package active_campaign
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 var (
 	// myToken is a non-empty string to use during tests.
 	myToken = "my-token"
+
+	// ctx is a non-nil context to use during tests.
+	ctx = context.Background()
 )
 
 // setup sets up a test HTTP server along with a active_campaign.Client that is configured to talk to that test server.
 // Tests should register handlers on mux which provide mock responses for the API method being tested.
+//
+// Client-side rate limiting is disabled so that tests are not slowed down by it.
 func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown func()) {
+	return setupWithOpts(&ClientOpts{RequestsPerSecond: -1})
+}
+
+// setupWithOpts is like setup, but builds the client from opts. BaseUrl and Token are overwritten.
+func setupWithOpts(opts *ClientOpts) (client *Client, mux *http.ServeMux, serverURL string, teardown func()) {
 	// mux is the HTTP request multiplexer used with the test server.
 	mux = http.NewServeMux()
 
 	// server is a test HTTP server used to provide mock API responses.
 	server := httptest.NewServer(mux)
 
-	// client is the GitHub client being tested and is
+	// client is the Active Campaign client being tested and is
 	// configured to use test server.
-	client, _ = NewClient(&ClientOpts{
-		HttpClient: nil,
-		BaseUrl:    server.URL,
-		Token:      myToken,
-	})
+	opts.BaseUrl = server.URL
+	opts.Token = myToken
+	client, _ = NewClient(opts)
 
 	return client, mux, server.URL, server.Close
 }
 
+// closeCountingClient is an httpClient that counts how many response bodies have been closed.
+type closeCountingClient struct {
+	closes int32
+}
+
+func (c *closeCountingClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if resp != nil {
+		resp.Body = &countingBody{ReadCloser: resp.Body, closes: &c.closes}
+	}
+	return resp, err
+}
+
+type countingBody struct {
+	io.ReadCloser
+	closes *int32
+}
+
+func (b *countingBody) Close() error {
+	atomic.AddInt32(b.closes, 1)
+	return b.ReadCloser.Close()
+}
+
+// setupCountingCloses is like setup, but also returns a function reporting how many response bodies were closed.
+func setupCountingCloses() (client *Client, mux *http.ServeMux, closes func() int, teardown func()) {
+	hc := &closeCountingClient{}
+	client, mux, _, teardown = setupWithOpts(&ClientOpts{RequestsPerSecond: -1, HttpClient: hc})
+	return client, mux, func() int { return int(atomic.LoadInt32(&hc.closes)) }, teardown
+}
+
 func TestNewClient_addsTrailingSlashToURLs(t *testing.T) {
 	baseURL := "https://custom-url/api/3"
 	formattedBaseURL := baseURL + "/"
 
 	c, err := NewClient(
 		&ClientOpts{
-			nil,
-			baseURL,
-			"",
+			HttpClient: nil,
+			BaseUrl:    baseURL,
+			Token:      "",
 		},
 	)
 	if err != nil {
@@ -62,9 +105,9 @@ func TestNewClient_addsApi3ToURLs(t *testing.T) {
 
 	c, err := NewClient(
 		&ClientOpts{
-			nil,
-			baseURL,
-			"",
+			HttpClient: nil,
+			BaseUrl:    baseURL,
+			Token:      "",
 		},
 	)
 	if err != nil {
@@ -81,9 +124,9 @@ func TestNewRequest(t *testing.T) {
 
 	c, err := NewClient(
 		&ClientOpts{
-			nil,
-			baseURL,
-			"my-token",
+			HttpClient: nil,
+			BaseUrl:    baseURL,
+			Token:      "my-token",
 		},
 	)
 	if err != nil {
@@ -133,6 +176,92 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_errorsArray(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "contacts"}},
+		StatusCode: http.StatusUnprocessableEntity,
+		Body: ioutil.NopCloser(strings.NewReader(`{"errors":[{"title":"Email address already exists in the system.",` +
+			`"detail":"","code":"duplicate","source":{"pointer":"/data/attributes/email"}}]}`)),
+	}
+	err := CheckResponse(res)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+	want := &ErrorResponse{
+		Response: res,
+		Errors: []Error{{
+			Title:  "Email address already exists in the system.",
+			Code:   "duplicate",
+			Source: &ErrorSource{Pointer: "/data/attributes/email"},
+		}},
+	}
+	if !reflect.DeepEqual(errResp, want) {
+		t.Errorf("Error = %#v, want %#v", errResp, want)
+	}
+	if got, want := err.Error(), "POST contacts: 422 Email address already exists in the system."; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !IsDuplicate(err) {
+		t.Errorf("IsDuplicate returned false, want true")
+	}
+	if !IsValidation(err) {
+		t.Errorf("IsValidation returned false, want true")
+	}
+	if IsNotFound(err) || IsRateLimited(err) {
+		t.Errorf("IsNotFound or IsRateLimited returned true, want false")
+	}
+}
+
+func TestCheckResponse_message(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "contacts/1"}},
+		StatusCode: http.StatusNotFound,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message": "No Result found for Subscriber with id 1"}`)),
+	}
+	err := CheckResponse(res)
+
+	if got, want := err.Error(), "GET contacts/1: 404 No Result found for Subscriber with id 1"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound returned false, want true")
+	}
+
+	// the body should still be readable by the caller
+	body, _ := ioutil.ReadAll(res.Body)
+	if got, want := string(body), `{"message": "No Result found for Subscriber with id 1"}`; got != want {
+		t.Errorf("Response body = %q, want %q", got, want)
+	}
+}
+
+func TestCheckResponse_noBody(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "tags"}},
+		StatusCode: http.StatusTooManyRequests,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	err := CheckResponse(res)
+
+	if !IsRateLimited(err) {
+		t.Errorf("IsRateLimited returned false, want true")
+	}
+	if IsDuplicate(err) {
+		t.Errorf("IsDuplicate returned true, want false")
+	}
+}
+
+func TestIsNotFound_wrapped(t *testing.T) {
+	err := fmt.Errorf("retrieving tag: %w", &ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}})
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound returned false for a wrapped *ErrorResponse, want true")
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Errorf("IsNotFound returned true for a plain error, want false")
+	}
+}
+
 func testURLParseError(t *testing.T, err error) {
 	if err == nil {
 		t.Errorf("Expected error to be returned")
@@ -149,6 +278,21 @@ func testMethod(t *testing.T, r *http.Request, want string) {
 	}
 }
 
+type values map[string]string
+
+func testFormValues(t *testing.T, r *http.Request, values values) {
+	t.Helper()
+	want := url.Values{}
+	for k, v := range values {
+		want.Set(k, v)
+	}
+
+	_ = r.ParseForm()
+	if got := r.Form; !reflect.DeepEqual(got, want) {
+		t.Errorf("Request parameters: %v, want %v", got, want)
+	}
+}
+
 func testRequestURL(t *testing.T, r *http.Request, want string) {
 	if got := r.URL.String(); !strings.HasPrefix(got, want) {
 		t.Errorf("Request URL: %v, want %v", got, want)
@@ -190,6 +334,32 @@ func TestClient_NewRequest_EmptyBody(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequestWithContext(t *testing.T) {
+	c, err := NewClient(&ClientOpts{
+		HttpClient: nil,
+		BaseUrl:    "",
+		Token:      "",
+	})
+	if err != nil {
+		t.Errorf("An error occurred. Expected nil. Got %+v.", err)
+	}
+
+	type key struct{}
+	want := context.WithValue(context.Background(), key{}, "v")
+	req, err := c.NewRequestWithContext(want, "GET", "/", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext returned unexpected error: %v", err)
+	}
+	if got := req.Context(); got != want {
+		t.Errorf("NewRequestWithContext context is %v, want %v", got, want)
+	}
+
+	_, err = c.NewRequestWithContext(nil, "GET", "/", nil)
+	if !errors.Is(err, errNonNilContext) {
+		t.Errorf("Expected context must be non-nil error, got %v", err)
+	}
+}
+
 func TestClient_Do(t *testing.T) {
 	c, mux, _, teardown := setup()
 	defer teardown()
@@ -207,7 +377,7 @@ func TestClient_Do(t *testing.T) {
 
 	req, _ := c.NewRequest("GET", "/api/3/", nil)
 	body := new(foo)
-	_, _ = c.Do(req, body)
+	_, _ = c.Do(ctx, req, body)
 
 	want := &foo{"a"}
 	if !reflect.DeepEqual(body, want) {
@@ -215,6 +385,61 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestClient_Do_nilContext(t *testing.T) {
+	c, _, _, teardown := setup()
+	defer teardown()
+
+	req, _ := c.NewRequest("GET", "/", nil)
+	_, err := c.Do(nil, req, nil)
+
+	if !errors.Is(err, errNonNilContext) {
+		t.Errorf("Expected context must be non-nil error, got %v", err)
+	}
+}
+
+func TestClient_Do_contextCanceled(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := c.NewRequest("GET", "/", nil)
+	_, err := c.Do(canceled, req, nil)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClient_Do_contextDeadlineExceeded(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	})
+
+	timeout, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := c.NewRequest("GET", "/", nil)
+	_, err := c.Do(timeout, req, nil)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
 func TestClient_Do_HTTPResponse(t *testing.T) {
 	c, mux, _, teardown := setup()
 	defer teardown()
@@ -231,7 +456,7 @@ func TestClient_Do_HTTPResponse(t *testing.T) {
 	})
 
 	req, _ := c.NewRequest("GET", "/", nil)
-	res, _ := c.Do(req, nil)
+	res, _ := c.Do(ctx, req, nil)
 	_, err := ioutil.ReadAll(res.Body)
 
 	if err != nil {
@@ -250,7 +475,7 @@ func TestClient_Do_HTTPError(t *testing.T) {
 	})
 
 	req, _ := c.NewRequest("GET", "/api/3/contacts", nil)
-	_, err := c.Do(req, nil)
+	_, err := c.Do(ctx, req, nil)
 
 	if err == nil {
 		t.Error("Expected HTTP 400 error.")
@@ -268,5 +493,272 @@ func TestTransport_HeaderContainsApiToken(t *testing.T) {
 			t.Errorf("request does not contain Api-Token header")
 		}
 	})
-	c.Contacts.Create(nil)
+	c.Contacts.Create(ctx, nil)
+}
+
+func TestClient_Do_emptyBody(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, _ := c.NewRequest("DELETE", "/", nil)
+	body := new(struct{ A string })
+	_, err := c.Do(ctx, req, body)
+	if err != nil {
+		t.Errorf("Do returned unexpected error for an empty body: %v", err)
+	}
+}
+
+func TestClient_doNoContent_closesBody(t *testing.T) {
+	c, mux, closes, teardown := setupCountingCloses()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/ok", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/api/3/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "No Result found"}`, http.StatusNotFound)
+	})
+
+	req, _ := c.NewRequest("DELETE", "ok", nil)
+	if _, err := c.doNoContent(ctx, req); err != nil {
+		t.Errorf("doNoContent returned error: %v", err)
+	}
+	req, _ = c.NewRequest("DELETE", "missing", nil)
+	if _, err := c.doNoContent(ctx, req); !IsNotFound(err) {
+		t.Errorf("doNoContent returned error %v, want a not found error", err)
+	}
+
+	if got := closes(); got != 2 {
+		t.Errorf("doNoContent closed %d response bodies, want 2", got)
+	}
+}
+
+// TestNoContentMethods_closeBody checks that methods which return no result close the response body.
+func TestNoContentMethods_closeBody(t *testing.T) {
+	c, mux, closes, teardown := setupCountingCloses()
+	defer teardown()
+
+	var requests int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+	}{
+		{"Contacts.Delete", func() (*Response, error) { return c.Contacts.Delete(ctx, "1") }},
+		{"Lists.Delete", func() (*Response, error) { return c.Lists.Delete(ctx, "1") }},
+		{"Tags.Delete", func() (*Response, error) { return c.Tags.Delete(ctx, "1") }},
+		{"Contacts.RemoveTagFromContact", func() (*Response, error) { return c.Contacts.RemoveTagFromContact(ctx, "1") }},
+		{"Fields.Delete", func() (*Response, error) { return c.Fields.Delete(ctx, "1") }},
+		{"Contacts.DeleteFieldValue", func() (*Response, error) { return c.Contacts.DeleteFieldValue(ctx, "1") }},
+		{"Deals.Delete", func() (*Response, error) { return c.Deals.Delete(ctx, "1") }},
+		{"Pipelines.Delete", func() (*Response, error) { return c.Pipelines.Delete(ctx, "1") }},
+		{"Stages.Delete", func() (*Response, error) { return c.Stages.Delete(ctx, "1") }},
+		{"Stages.MoveDeals", func() (*Response, error) { return c.Stages.MoveDeals(ctx, "1", "2") }},
+		{"Stages.DeleteMovingDeals", func() (*Response, error) { return c.Stages.DeleteMovingDeals(ctx, "1", "2") }},
+		{"DealFields.Delete", func() (*Response, error) { return c.DealFields.Delete(ctx, "1") }},
+		{"DealFields.DeleteValue", func() (*Response, error) { return c.DealFields.DeleteValue(ctx, "1") }},
+		{"DealFields.BulkCreateValues", func() (*Response, error) { return c.DealFields.BulkCreateValues(ctx, nil) }},
+		{"DealFields.BulkUpdateValues", func() (*Response, error) { return c.DealFields.BulkUpdateValues(ctx, nil) }},
+		{"Accounts.Delete", func() (*Response, error) { return c.Accounts.Delete(ctx, "1") }},
+		{"Accounts.BulkDelete", func() (*Response, error) { return c.Accounts.BulkDelete(ctx, []string{"1", "2"}) }},
+		{"Accounts.DeleteField", func() (*Response, error) { return c.Accounts.DeleteField(ctx, "1") }},
+		{"Accounts.DeleteFieldValue", func() (*Response, error) { return c.Accounts.DeleteFieldValue(ctx, "1") }},
+		{"Accounts.RemoveContact", func() (*Response, error) { return c.Accounts.RemoveContact(ctx, "1") }},
+		{"Automations.RemoveContact", func() (*Response, error) { return c.Automations.RemoveContact(ctx, "1") }},
+		{"Messages.Delete", func() (*Response, error) { return c.Messages.Delete(ctx, "1") }},
+		{"Connections.Delete", func() (*Response, error) { return c.Connections.Delete(ctx, "1") }},
+	}
+	for _, tt := range tests {
+		closesBefore, requestsBefore := closes(), int(atomic.LoadInt32(&requests))
+		if _, err := tt.call(); err != nil {
+			t.Errorf("%s returned error: %v", tt.name, err)
+		}
+		got, want := closes()-closesBefore, int(atomic.LoadInt32(&requests))-requestsBefore
+		if got != want {
+			t.Errorf("%s closed %d response bodies, want %d", tt.name, got, want)
+		}
+	}
+}
+
+func TestClient_Do_pageValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"tags": [], "meta": {"total": "245"}}`)
+	})
+
+	tests := []struct {
+		opts           *ListOptions
+		wantNextOffset int
+	}{
+		{nil, 20},
+		{&ListOptions{Limit: 10, Offset: 10}, 20},
+		{&ListOptions{Limit: 20, Offset: 240}, 0},
+		{&ListOptions{Limit: 100}, 100},
+		// Active Campaign returns at most 100 items per page, so larger limits must not skip items.
+		{&ListOptions{Limit: 200}, 100},
+	}
+	for _, tt := range tests {
+		_, resp, err := c.Tags.ListAll(ctx, tt.opts)
+		if err != nil {
+			t.Fatalf("Tags.ListAll returned error: %v", err)
+		}
+		if resp.Total != 245 {
+			t.Errorf("Response.Total = %d, want 245", resp.Total)
+		}
+		if resp.NextOffset != tt.wantNextOffset {
+			t.Errorf("Tags.ListAll(%+v) Response.NextOffset = %d, want %d", tt.opts, resp.NextOffset, tt.wantNextOffset)
+		}
+	}
+}
+
+func TestClient_Do_pageValuesNumericTotal(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"deals": [], "meta": {"total": 3}}`)
+	})
+
+	req, _ := c.NewRequest("GET", "deals", nil)
+	resp, err := c.Do(ctx, req, new(struct{}))
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.Total != 3 || resp.NextOffset != 0 {
+		t.Errorf("Response Total, NextOffset = %d, %d, want 3, 0", resp.Total, resp.NextOffset)
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("offset") {
+		case "":
+			testFormValues(t, r, values{"limit": "2"})
+			_, _ = fmt.Fprint(w, `{"tags": [{"id": "1"}, {"id": "2"}], "meta": {"total": "5"}}`)
+		case "2":
+			testFormValues(t, r, values{"limit": "2", "offset": "2"})
+			_, _ = fmt.Fprint(w, `{"tags": [{"id": "3"}, {"id": "4"}], "meta": {"total": "5"}}`)
+		case "4":
+			testFormValues(t, r, values{"limit": "2", "offset": "4"})
+			_, _ = fmt.Fprint(w, `{"tags": [{"id": "5"}], "meta": {"total": "5"}}`)
+		default:
+			t.Errorf("Unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var ids []string
+	err := ListAllPages(ctx, &ListOptions{Limit: 2}, func(opts *ListOptions) (*Response, error) {
+		page, resp, err := c.Tags.ListAll(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, tag := range page.Tags {
+			ids = append(ids, tag.ID)
+		}
+		return resp, nil
+	})
+	if err != nil {
+		t.Fatalf("ListAllPages returned error: %v", err)
+	}
+
+	if want := []string{"1", "2", "3", "4", "5"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListAllPages visited %v, want %v", ids, want)
+	}
+}
+
+func TestListAllPages_stopsOnError(t *testing.T) {
+	want := errors.New("boom")
+	calls := 0
+	err := ListAllPages(ctx, nil, func(opts *ListOptions) (*Response, error) {
+		calls++
+		return &Response{NextOffset: 20}, want
+	})
+	if err != want {
+		t.Errorf("ListAllPages returned %v, want %v", err, want)
+	}
+	if calls != 1 {
+		t.Errorf("ListAllPages called list %d times, want 1", calls)
+	}
+}
+
+func TestListAllPages_canceled(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := ListAllPages(canceled, nil, func(opts *ListOptions) (*Response, error) {
+		calls++
+		cancel()
+		return &Response{NextOffset: opts.Offset + 20}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ListAllPages returned %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("ListAllPages called list %d times, want 1", calls)
+	}
+}
+
+func TestAddOptions(t *testing.T) {
+	type embedded struct {
+		Search string `url:"search,omitempty"`
+	}
+	type opts struct {
+		ListOptions
+		embedded
+		Filter  string    `url:"filters[name]"`
+		Order   string    `url:"orders[cdate],omitempty"`
+		IDs     []string  `url:"ids[],omitempty"`
+		Active  bool      `url:"active,omitempty"`
+		Zero    *int      `url:"zero"`
+		Skipped string    `url:"-"`
+		Before  time.Time `url:"filters[created_before],omitempty"`
+	}
+	zero := 0
+
+	got, err := addOptions("contacts?status=1", &opts{
+		ListOptions: ListOptions{Limit: 5},
+		embedded:    embedded{Search: "s"},
+		IDs:         []string{"1", "2"},
+		Active:      true,
+		Zero:        &zero,
+		Skipped:     "x",
+		Before:      time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+
+	u, _ := url.Parse(got)
+	want := url.Values{
+		"status":                  {"1"},
+		"limit":                   {"5"},
+		"search":                  {"s"},
+		"filters[name]":           {""},
+		"ids[]":                   {"1", "2"},
+		"active":                  {"1"},
+		"zero":                    {"0"},
+		"filters[created_before]": {"2020-01-02T03:04:05Z"},
+	}
+	if !reflect.DeepEqual(u.Query(), want) {
+		t.Errorf("addOptions query = %v, want %v", u.Query(), want)
+	}
+
+	var nilOpts *ListOptions
+	if got, err := addOptions("tags", nilOpts); got != "tags" || err != nil {
+		t.Errorf("addOptions with nil opts = %q, %v, want %q, nil", got, err, "tags")
+	}
 }
@@ -0,0 +1,102 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// AutomationsService handles communication with automation related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#automation
+type AutomationsService service
+
+// AutomationStatus is whether an automation is running.
+type AutomationStatus string
+
+const (
+	AutomationStatusActive   AutomationStatus = "1"
+	AutomationStatusInactive AutomationStatus = "2"
+)
+
+// String returns a readable name for the status.
+func (s AutomationStatus) String() string {
+	switch s {
+	case AutomationStatusActive:
+		return "active"
+	case AutomationStatusInactive:
+		return "inactive"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes an automation status. See unmarshalStatus.
+func (s *AutomationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// Automation is a workflow that contacts enter and move through.
+type Automation struct {
+	Name   string           `json:"name"`
+	Status AutomationStatus `json:"status"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate             string           `json:"cdate,omitempty"`
+	Mdate             string           `json:"mdate,omitempty"`
+	UserID            string           `json:"userid,omitempty"`
+	Entered           string           `json:"entered,omitempty"`
+	Exited            string           `json:"exited,omitempty"`
+	Hidden            string           `json:"hidden,omitempty"`
+	DefaultScreenshot string           `json:"defaultscreenshot,omitempty"`
+	Screenshot        string           `json:"screenshot,omitempty"`
+	Links             *AutomationLinks `json:"links,omitempty"`
+	ID                string           `json:"id,omitempty"`
+}
+
+// AutomationLinks are the related resource URLs returned with an automation.
+type AutomationLinks struct {
+	Campaigns          string `json:"campaigns"`
+	ContactGoals       string `json:"contactGoals"`
+	ContactAutomations string `json:"contactAutomations"`
+	Blocks             string `json:"blocks"`
+	Goals              string `json:"goals"`
+	Sms                string `json:"sms"`
+	SiteMessages       string `json:"sitemessages"`
+}
+
+// ListAutomationsOptions specifies the optional parameters to AutomationsService.List.
+type ListAutomationsOptions struct {
+	ListOptions
+
+	// Name filters automations whose name contains the given value.
+	Name   string           `url:"filters[name],omitempty"`
+	Status AutomationStatus `url:"filters[status],omitempty"`
+}
+
+// ListAutomationsResponse is the response body returned from listing automations.
+type ListAutomationsResponse struct {
+	Automations []*Automation `json:"automations"`
+	Meta        *Meta         `json:"meta"`
+}
+
+// List automations, one page at a time. Use ListAllPages to walk every page.
+func (s *AutomationsService) List(ctx context.Context, opts *ListAutomationsOptions) (*ListAutomationsResponse, *Response, error) {
+	u, err := addOptions("automations", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAutomationsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
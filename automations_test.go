@@ -0,0 +1,90 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAutomationsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/automations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[status]": "1", "filters[name]": "Onboarding", "limit": "10"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"automations": [
+					{
+						"name": "Onboarding",
+						"cdate": "2020-06-24T12:00:00-05:00",
+						"mdate": "2020-06-24T12:00:00-05:00",
+						"userid": "1",
+						"status": 1,
+						"entered": "12",
+						"exited": "3",
+						"hidden": "0",
+						"links": {
+							"contactAutomations": "https://your_base_url.api-us1.com/api/3/automations/4/contactAutomations"
+						},
+						"id": "4"
+					}
+				],
+				"meta": {"total": "1", "starts": [], "filtered": false}
+			}`)
+	})
+
+	opts := &ListAutomationsOptions{ListOptions: ListOptions{Limit: 10}, Name: "Onboarding", Status: AutomationStatusActive}
+	automations, resp, err := c.Automations.List(ctx, opts)
+	if err != nil {
+		t.Fatalf("Automations.List returned error: %v", err)
+	}
+
+	want := &ListAutomationsResponse{
+		Automations: []*Automation{
+			{
+				Name:    "Onboarding",
+				Status:  AutomationStatusActive,
+				Cdate:   "2020-06-24T12:00:00-05:00",
+				Mdate:   "2020-06-24T12:00:00-05:00",
+				UserID:  "1",
+				Entered: "12",
+				Exited:  "3",
+				Hidden:  "0",
+				Links: &AutomationLinks{
+					ContactAutomations: "https://your_base_url.api-us1.com/api/3/automations/4/contactAutomations",
+				},
+				ID: "4",
+			},
+		},
+		Meta: &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(automations, want) {
+		t.Errorf("Automations.List returned %+v, want %+v", automations, want)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Automations.List returned Total %d, want 1", resp.Total)
+	}
+	if got := automations.Automations[0].Status.String(); got != "active" {
+		t.Errorf("Automation status String() = %q, want %q", got, "active")
+	}
+}
+
+func TestAutomationStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status AutomationStatus `json:"status"`
+	}
+	for in, want := range map[string]AutomationStatus{`{"status": 2}`: AutomationStatusInactive, `{"status": "2"}`: AutomationStatusInactive, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
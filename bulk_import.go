@@ -0,0 +1,212 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Bulk Imports are part of the Contacts Service.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#bulk-import-contacts
+
+// MaxBulkImportContacts is the maximum number of contacts Active Campaign accepts in a single bulk import.
+const MaxBulkImportContacts = 250
+
+// BulkImportStatusCompleted is the status of a bulk import batch that has finished processing.
+const BulkImportStatusCompleted = "completed"
+
+// BulkImportContact is a contact to be created or updated by a bulk import.
+type BulkImportContact struct {
+	Email            string   `json:"email"`
+	FirstName        string   `json:"first_name,omitempty"`
+	LastName         string   `json:"last_name,omitempty"`
+	Phone            string   `json:"phone,omitempty"`
+	CustomerAcctName string   `json:"customer_acct_name,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+
+	// Fields sets custom field values on the contact.
+	Fields []*BulkImportField `json:"fields,omitempty"`
+
+	// Subscribe and Unsubscribe change the contact's status on lists.
+	Subscribe   []*BulkImportList `json:"subscribe,omitempty"`
+	Unsubscribe []*BulkImportList `json:"unsubscribe,omitempty"`
+}
+
+// BulkImportField is a custom field value set by a bulk import.
+type BulkImportField struct {
+	ID    int    `json:"id"`
+	Value string `json:"value"`
+}
+
+// BulkImportList identifies a list a bulk imported contact is subscribed to or unsubscribed from.
+type BulkImportList struct {
+	ListID int `json:"listid"`
+}
+
+// BulkImportRequest is the request body used for bulk importing contacts.
+type BulkImportRequest struct {
+	Contacts []*BulkImportContact `json:"contacts"`
+}
+
+// BulkImportResponse is the response body from bulk importing contacts.
+type BulkImportResponse struct {
+	Success        int    `json:"success"`
+	QueuedContacts int    `json:"queued_contacts"`
+	BatchID        string `json:"batchId"`
+	Message        string `json:"message,omitempty"`
+}
+
+// BulkImport queues up to MaxBulkImportContacts contacts to be created or updated.
+// Use BulkImportInfo to follow the progress of the returned batch, or BulkImportAndWait to import any number of contacts.
+func (s *ContactsService) BulkImport(ctx context.Context, contacts *BulkImportRequest) (*BulkImportResponse, *Response, error) {
+	u := "import/bulk_import"
+	req, err := s.client.NewRequest(http.MethodPost, u, contacts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &BulkImportResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// BulkImportBatch is the status of a single bulk import batch.
+type BulkImportBatch struct {
+	BatchID string `json:"batchId"`
+	Status  string `json:"status"`
+}
+
+// BulkImportStatusResponse is the response body from retrieving the status of recent bulk imports.
+type BulkImportStatusResponse struct {
+	Outstanding       []*BulkImportBatch `json:"outstanding"`
+	RecentlyCompleted []*BulkImportBatch `json:"recentlyCompleted"`
+}
+
+// BulkImportStatus lists the outstanding and recently completed bulk imports of the account.
+func (s *ContactsService) BulkImportStatus(ctx context.Context) (*BulkImportStatusResponse, *Response, error) {
+	u := "import/bulk_import"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &BulkImportStatusResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// BulkImportInfoResponse is the response body from retrieving the progress of a bulk import batch.
+type BulkImportInfoResponse struct {
+	Status string `json:"status"`
+
+	// Success holds the IDs of the contacts that were imported.
+	Success []json.Number `json:"success"`
+
+	// Failure describes the contacts that could not be imported.
+	Failure []interface{} `json:"failure"`
+}
+
+// BulkImportInfo retrieves the progress of a bulk import batch.
+func (s *ContactsService) BulkImportInfo(ctx context.Context, batchID string) (*BulkImportInfoResponse, *Response, error) {
+	u := "import/info?batchId=" + url.QueryEscape(batchID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &BulkImportInfoResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DefaultBulkImportPollInterval is how often BulkImportAndWait polls when it is given a pollInterval of zero or less.
+const DefaultBulkImportPollInterval = time.Second
+
+// BulkImportError is returned by BulkImportAndWait when it fails after queueing some batches.
+// Those batches may still be imported, and can be followed with BulkImportInfo.
+type BulkImportError struct {
+	// BatchIDs holds the IDs of the batches that were queued, in the order the contacts were given.
+	BatchIDs []string
+
+	Err error
+}
+
+func (e *BulkImportError) Error() string {
+	return fmt.Sprintf("bulk import failed after queueing %d batches: %v", len(e.BatchIDs), e.Err)
+}
+
+func (e *BulkImportError) Unwrap() error {
+	return e.Err
+}
+
+// BulkImportAndWait imports any number of contacts by splitting them into batches of MaxBulkImportContacts,
+// then polls every pollInterval until each batch has completed. It returns the final info of every batch,
+// in the order the contacts were given. Use ctx to bound how long to wait.
+//
+// If it fails after queueing any batch, the error is a *BulkImportError holding the queued batch IDs, and
+// the returned infos hold the final info of each batch that completed before the failure, or nil.
+func (s *ContactsService) BulkImportAndWait(ctx context.Context, contacts []*BulkImportContact, pollInterval time.Duration) ([]*BulkImportInfoResponse, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultBulkImportPollInterval
+	}
+
+	var batchIDs []string
+	for start := 0; start < len(contacts); start += MaxBulkImportContacts {
+		end := start + MaxBulkImportContacts
+		if end > len(contacts) {
+			end = len(contacts)
+		}
+
+		imported, _, err := s.BulkImport(ctx, &BulkImportRequest{Contacts: contacts[start:end]})
+		if err != nil {
+			if len(batchIDs) == 0 {
+				return nil, err
+			}
+			return make([]*BulkImportInfoResponse, len(batchIDs)), &BulkImportError{BatchIDs: batchIDs, Err: err}
+		}
+		batchIDs = append(batchIDs, imported.BatchID)
+	}
+
+	infos := make([]*BulkImportInfoResponse, len(batchIDs))
+	for i, batchID := range batchIDs {
+		for {
+			info, _, err := s.BulkImportInfo(ctx, batchID)
+			if err != nil {
+				return infos, &BulkImportError{BatchIDs: batchIDs, Err: err}
+			}
+			if info.Status == BulkImportStatusCompleted {
+				infos[i] = info
+				break
+			}
+
+			timer := time.NewTimer(pollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return infos, &BulkImportError{BatchIDs: batchIDs, Err: ctx.Err()}
+			case <-timer.C:
+			}
+		}
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,240 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestContactsService_BulkImport(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &BulkImportRequest{
+		Contacts: []*BulkImportContact{
+			{
+				Email:       "e",
+				FirstName:   "f",
+				Tags:        []string{"t"},
+				Fields:      []*BulkImportField{{ID: 1, Value: "v"}},
+				Subscribe:   []*BulkImportList{{ListID: 2}},
+				Unsubscribe: []*BulkImportList{{ListID: 3}},
+			},
+		},
+	}
+
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		want := `{"contacts":[{"email":"e","first_name":"f","tags":["t"],"fields":[{"id":1,"value":"v"}],` +
+			`"subscribe":[{"listid":2}],"unsubscribe":[{"listid":3}]}]}` + "\n"
+		if got := string(body); got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"success": 1, "queued_contacts": 1, "batchId": "b"}`)
+	})
+
+	imported, _, err := c.Contacts.BulkImport(ctx, input)
+	if err != nil {
+		t.Fatalf("Contacts.BulkImport returned error: %v", err)
+	}
+
+	want := &BulkImportResponse{Success: 1, QueuedContacts: 1, BatchID: "b"}
+	if !reflect.DeepEqual(imported, want) {
+		t.Errorf("Contacts.BulkImport returned %+v, want %+v", imported, want)
+	}
+}
+
+func TestContactsService_BulkImportStatus(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"outstanding": [{"batchId": "a", "status": "pending"}],
+				"recentlyCompleted": [{"batchId": "b", "status": "completed"}]
+			}`)
+	})
+
+	status, _, err := c.Contacts.BulkImportStatus(ctx)
+	if err != nil {
+		t.Fatalf("Contacts.BulkImportStatus returned error: %v", err)
+	}
+
+	want := &BulkImportStatusResponse{
+		Outstanding:       []*BulkImportBatch{{BatchID: "a", Status: "pending"}},
+		RecentlyCompleted: []*BulkImportBatch{{BatchID: "b", Status: "completed"}},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Contacts.BulkImportStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestContactsService_BulkImportInfo(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/import/info", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"batchId": "b"})
+		_, _ = fmt.Fprint(w, `{"status": "completed", "success": [1, "2"], "failure": []}`)
+	})
+
+	info, _, err := c.Contacts.BulkImportInfo(ctx, "b")
+	if err != nil {
+		t.Fatalf("Contacts.BulkImportInfo returned error: %v", err)
+	}
+
+	want := &BulkImportInfoResponse{
+		Status:  "completed",
+		Success: []json.Number{"1", "2"},
+		Failure: []interface{}{},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("Contacts.BulkImportInfo returned %+v, want %+v", info, want)
+	}
+}
+
+func TestContactsService_BulkImportAndWait(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	var batchSizes []int
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(BulkImportRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+		batchSizes = append(batchSizes, len(v.Contacts))
+		_, _ = fmt.Fprintf(w, `{"success": 1, "queued_contacts": %d, "batchId": "%d"}`, len(v.Contacts), len(batchSizes))
+	})
+
+	polls := map[string]int{}
+	mux.HandleFunc("/api/3/import/info", func(w http.ResponseWriter, r *http.Request) {
+		batchID := r.URL.Query().Get("batchId")
+		polls[batchID]++
+		if polls[batchID] < 2 {
+			_, _ = fmt.Fprint(w, `{"status": "pending"}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"status": "completed", "success": ["%s"]}`, batchID)
+	})
+
+	contacts := make([]*BulkImportContact, 2*MaxBulkImportContacts+1)
+	for i := range contacts {
+		contacts[i] = &BulkImportContact{Email: strconv.Itoa(i)}
+	}
+
+	infos, err := c.Contacts.BulkImportAndWait(ctx, contacts, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Contacts.BulkImportAndWait returned error: %v", err)
+	}
+
+	if want := []int{MaxBulkImportContacts, MaxBulkImportContacts, 1}; !reflect.DeepEqual(batchSizes, want) {
+		t.Errorf("Contacts.BulkImportAndWait sent batches of %v, want %v", batchSizes, want)
+	}
+	if len(infos) != 3 {
+		t.Fatalf("Contacts.BulkImportAndWait returned %d infos, want 3", len(infos))
+	}
+	for i, info := range infos {
+		want := &BulkImportInfoResponse{Status: "completed", Success: []json.Number{json.Number(strconv.Itoa(i + 1))}}
+		if !reflect.DeepEqual(info, want) {
+			t.Errorf("Contacts.BulkImportAndWait info %d = %+v, want %+v", i, info, want)
+		}
+	}
+}
+
+func TestContactsService_BulkImportAndWait_canceled(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"success": 1, "queued_contacts": 1, "batchId": "b"}`)
+	})
+	mux.HandleFunc("/api/3/import/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"status": "pending"}`)
+	})
+
+	timeout, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Contacts.BulkImportAndWait(timeout, []*BulkImportContact{{Email: "e"}}, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Contacts.BulkImportAndWait returned %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestContactsService_BulkImportAndWait_partialFailure(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	var batches int
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		batches++
+		if batches > 1 {
+			http.Error(w, `{"message": "Service unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"success": 1, "queued_contacts": 250, "batchId": "b1"}`)
+	})
+	mux.HandleFunc("/api/3/import/info", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Contacts.BulkImportAndWait polled after failing to queue a batch")
+	})
+
+	contacts := make([]*BulkImportContact, MaxBulkImportContacts+1)
+	for i := range contacts {
+		contacts[i] = &BulkImportContact{Email: strconv.Itoa(i)}
+	}
+
+	infos, err := c.Contacts.BulkImportAndWait(ctx, contacts, time.Millisecond)
+	var importErr *BulkImportError
+	if !errors.As(err, &importErr) {
+		t.Fatalf("Contacts.BulkImportAndWait returned %v, want a *BulkImportError", err)
+	}
+	if want := []string{"b1"}; !reflect.DeepEqual(importErr.BatchIDs, want) {
+		t.Errorf("BulkImportError.BatchIDs = %v, want %v", importErr.BatchIDs, want)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Contacts.BulkImportAndWait returned %v, want it to wrap an *ErrorResponse", err)
+	}
+	if len(infos) != 1 || infos[0] != nil {
+		t.Errorf("Contacts.BulkImportAndWait returned infos %v, want one nil info", infos)
+	}
+}
+
+func TestContactsService_BulkImportAndWait_zeroPollInterval(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/import/bulk_import", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"success": 1, "queued_contacts": 1, "batchId": "b"}`)
+	})
+	var polls int
+	mux.HandleFunc("/api/3/import/info", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		_, _ = fmt.Fprint(w, `{"status": "pending"}`)
+	})
+
+	timeout, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Contacts.BulkImportAndWait(timeout, []*BulkImportContact{{Email: "e"}}, 0)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Contacts.BulkImportAndWait returned %v, want context.DeadlineExceeded", err)
+	}
+	if polls != 1 {
+		t.Errorf("Contacts.BulkImportAndWait polled %d times with a zero interval, want 1", polls)
+	}
+}
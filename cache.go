@@ -0,0 +1,70 @@
+package active_campaign
+
+import (
+	"strings"
+	"sync"
+)
+
+// idCache remembers the IDs of contacts and tags that have been resolved by email or name,
+// so that higher level helpers such as ContactsService.TagByEmail do not look them up on every call.
+// It is shared by every service on a Client.
+type idCache struct {
+	mu       sync.RWMutex
+	contacts map[string]string // lower-cased email -> contact ID
+	tags     map[string]string // tag name -> tag ID
+}
+
+func newIDCache() *idCache {
+	return &idCache{
+		contacts: make(map[string]string),
+		tags:     make(map[string]string),
+	}
+}
+
+func (c *idCache) contactID(email string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	id, ok := c.contacts[strings.ToLower(email)]
+	return id, ok
+}
+
+func (c *idCache) setContactID(email, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contacts[strings.ToLower(email)] = id
+}
+
+func (c *idCache) tagID(name string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	id, ok := c.tags[name]
+	return id, ok
+}
+
+func (c *idCache) setTagID(name, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tags[name] = id
+}
+
+// forgetContact removes every cached email that resolves to the given contact ID.
+func (c *idCache) forgetContact(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for email, cached := range c.contacts {
+		if cached == id {
+			delete(c.contacts, email)
+		}
+	}
+}
+
+// forgetTag removes every cached name that resolves to the given tag ID.
+func (c *idCache) forgetTag(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, cached := range c.tags {
+		if cached == id {
+			delete(c.tags, name)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// CampaignsService handles communication with campaign related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#campaigns
+type CampaignsService service
+
+// CampaignStatus is the delivery state of a campaign.
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft     CampaignStatus = "0"
+	CampaignStatusScheduled CampaignStatus = "1"
+	CampaignStatusSending   CampaignStatus = "2"
+	CampaignStatusPaused    CampaignStatus = "3"
+	CampaignStatusStopped   CampaignStatus = "4"
+	CampaignStatusCompleted CampaignStatus = "5"
+)
+
+// String returns a readable name for the status.
+func (s CampaignStatus) String() string {
+	switch s {
+	case CampaignStatusDraft:
+		return "draft"
+	case CampaignStatusScheduled:
+		return "scheduled"
+	case CampaignStatusSending:
+		return "sending"
+	case CampaignStatusPaused:
+		return "paused"
+	case CampaignStatusStopped:
+		return "stopped"
+	case CampaignStatusCompleted:
+		return "completed"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes a campaign status. See unmarshalStatus.
+func (s *CampaignStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// Campaign is an email sent, or to be sent, to one or more lists.
+// Its reporting counters are decoded as integers, although Active Campaign sends them as strings.
+type Campaign struct {
+	Name      string         `json:"name"`
+	Type      string         `json:"type"`
+	Status    CampaignStatus `json:"status"`
+	UserID    string         `json:"userid"`
+	SegmentID string         `json:"segmentid"`
+	Public    string         `json:"public"`
+	Cdate     string         `json:"cdate"`
+	Mdate     string         `json:"mdate"`
+	// Sdate is when the campaign started sending, and Ldate when it last sent.
+	Sdate         string `json:"sdate"`
+	Ldate         string `json:"ldate"`
+	ScheduledDate string `json:"scheduleddate"`
+
+	// Reporting counters.
+	SendAmt          int `json:"send_amt"`
+	TotalAmt         int `json:"total_amt"`
+	Opens            int `json:"opens"`
+	UniqueOpens      int `json:"uniqueopens"`
+	LinkClicks       int `json:"linkclicks"`
+	UniqueLinkClicks int `json:"uniquelinkclicks"`
+	SubscriberClicks int `json:"subscriberclicks"`
+	Forwards         int `json:"forwards"`
+	UniqueForwards   int `json:"uniqueforwards"`
+	HardBounces      int `json:"hardbounces"`
+	SoftBounces      int `json:"softbounces"`
+	Unsubscribes     int `json:"unsubscribes"`
+	UnsubReasons     int `json:"unsubreasons"`
+	Updates          int `json:"updates"`
+	SocialShares     int `json:"socialshares"`
+	Replies          int `json:"replies"`
+	UniqueReplies    int `json:"uniquereplies"`
+
+	TrackLinks string         `json:"tracklinks"`
+	TrackReads string         `json:"trackreads"`
+	Automation string         `json:"automation,omitempty"`
+	Links      *CampaignLinks `json:"links,omitempty"`
+	ID         string         `json:"id"`
+}
+
+// UnmarshalJSON decodes a campaign, parsing its reporting counters from strings or numbers.
+func (c *Campaign) UnmarshalJSON(data []byte) error {
+	type campaign Campaign
+	var raw struct {
+		*campaign
+		SendAmt          json.Number `json:"send_amt"`
+		TotalAmt         json.Number `json:"total_amt"`
+		Opens            json.Number `json:"opens"`
+		UniqueOpens      json.Number `json:"uniqueopens"`
+		LinkClicks       json.Number `json:"linkclicks"`
+		UniqueLinkClicks json.Number `json:"uniquelinkclicks"`
+		SubscriberClicks json.Number `json:"subscriberclicks"`
+		Forwards         json.Number `json:"forwards"`
+		UniqueForwards   json.Number `json:"uniqueforwards"`
+		HardBounces      json.Number `json:"hardbounces"`
+		SoftBounces      json.Number `json:"softbounces"`
+		Unsubscribes     json.Number `json:"unsubscribes"`
+		UnsubReasons     json.Number `json:"unsubreasons"`
+		Updates          json.Number `json:"updates"`
+		SocialShares     json.Number `json:"socialshares"`
+		Replies          json.Number `json:"replies"`
+		UniqueReplies    json.Number `json:"uniquereplies"`
+	}
+	raw.campaign = (*campaign)(c)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	counters := []struct {
+		name string
+		n    json.Number
+		dst  *int
+	}{
+		{"send_amt", raw.SendAmt, &c.SendAmt},
+		{"total_amt", raw.TotalAmt, &c.TotalAmt},
+		{"opens", raw.Opens, &c.Opens},
+		{"uniqueopens", raw.UniqueOpens, &c.UniqueOpens},
+		{"linkclicks", raw.LinkClicks, &c.LinkClicks},
+		{"uniquelinkclicks", raw.UniqueLinkClicks, &c.UniqueLinkClicks},
+		{"subscriberclicks", raw.SubscriberClicks, &c.SubscriberClicks},
+		{"forwards", raw.Forwards, &c.Forwards},
+		{"uniqueforwards", raw.UniqueForwards, &c.UniqueForwards},
+		{"hardbounces", raw.HardBounces, &c.HardBounces},
+		{"softbounces", raw.SoftBounces, &c.SoftBounces},
+		{"unsubscribes", raw.Unsubscribes, &c.Unsubscribes},
+		{"unsubreasons", raw.UnsubReasons, &c.UnsubReasons},
+		{"updates", raw.Updates, &c.Updates},
+		{"socialshares", raw.SocialShares, &c.SocialShares},
+		{"replies", raw.Replies, &c.Replies},
+		{"uniquereplies", raw.UniqueReplies, &c.UniqueReplies},
+	}
+	for _, counter := range counters {
+		if counter.n == "" {
+			*counter.dst = 0
+			continue
+		}
+		v, err := strconv.Atoi(counter.n.String())
+		if err != nil {
+			return fmt.Errorf("campaign %s: %w", counter.name, err)
+		}
+		*counter.dst = v
+	}
+
+	return nil
+}
+
+// CampaignLinks are the related resource URLs returned with a campaign.
+type CampaignLinks struct {
+	User              string `json:"user"`
+	Automation        string `json:"automation"`
+	CampaignMessage   string `json:"campaignMessage"`
+	CampaignMessages  string `json:"campaignMessages"`
+	Links             string `json:"links"`
+	AggregateRevenues string `json:"aggregateRevenues"`
+	Segment           string `json:"segment"`
+	CampaignLists     string `json:"campaignLists"`
+}
+
+// CampaignResponse is the response body returned from retrieving a campaign.
+type CampaignResponse struct {
+	Campaign *Campaign `json:"campaign"`
+}
+
+// ListCampaignsOptions specifies the optional parameters to CampaignsService.List.
+type ListCampaignsOptions struct {
+	ListOptions
+
+	// Orders sort the results. Each accepts "ASC" or "DESC".
+	OrderBySdate  string `url:"orders[sdate],omitempty"`
+	OrderByMdate  string `url:"orders[mdate],omitempty"`
+	OrderByLdate  string `url:"orders[ldate],omitempty"`
+	OrderByStatus string `url:"orders[status],omitempty"`
+}
+
+// ListCampaignsResponse is the response body returned from listing campaigns.
+type ListCampaignsResponse struct {
+	Campaigns []*Campaign `json:"campaigns"`
+	Meta      *Meta       `json:"meta"`
+}
+
+// Retrieve a campaign.
+func (s *CampaignsService) Retrieve(ctx context.Context, id string) (*CampaignResponse, *Response, error) {
+	u := "campaigns/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &CampaignResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// List campaigns, one page at a time. Use ListAllPages to walk every page.
+func (s *CampaignsService) List(ctx context.Context, opts *ListCampaignsOptions) (*ListCampaignsResponse, *Response, error) {
+	u, err := addOptions("campaigns", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListCampaignsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CampaignMessage is a message sent by a campaign. Split tested campaigns have several.
+type CampaignMessage struct {
+	CampaignID string `json:"campaignid"`
+	MessageID  string `json:"messageid"`
+	Percentage string `json:"percentage,omitempty"`
+	SourceSize string `json:"sourcesize,omitempty"`
+	SendAmt    string `json:"send_amt,omitempty"`
+	ID         string `json:"id"`
+}
+
+// ListCampaignMessagesResponse is the response body returned from listing the messages of a campaign.
+type ListCampaignMessagesResponse struct {
+	CampaignMessages []*CampaignMessage `json:"campaignMessages"`
+}
+
+// ListMessages lists the messages of a campaign.
+func (s *CampaignsService) ListMessages(ctx context.Context, id string) (*ListCampaignMessagesResponse, *Response, error) {
+	u := "campaigns/" + id + "/campaignMessages"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListCampaignMessagesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CampaignLink is a tracked link in a campaign message.
+type CampaignLink struct {
+	CampaignID string `json:"campaignid"`
+	MessageID  string `json:"messageid"`
+	Link       string `json:"link"`
+	Name       string `json:"name"`
+	Ref        string `json:"ref,omitempty"`
+	Tracked    string `json:"tracked"`
+	ID         string `json:"id"`
+}
+
+// ListCampaignLinksResponse is the response body returned from listing the links of a campaign.
+type ListCampaignLinksResponse struct {
+	Links []*CampaignLink `json:"links"`
+}
+
+// ListLinks lists the tracked links of a campaign.
+func (s *CampaignsService) ListLinks(ctx context.Context, id string) (*ListCampaignLinksResponse, *Response, error) {
+	u := "campaigns/" + id + "/links"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListCampaignLinksResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CampaignAutomationResponse is the response body returned from retrieving the automation of a campaign.
+type CampaignAutomationResponse struct {
+	Automation *Automation `json:"automation"`
+}
+
+// RetrieveAutomation retrieves the automation that sends a campaign. Automation is nil for campaigns that are
+// not part of an automation.
+func (s *CampaignsService) RetrieveAutomation(ctx context.Context, id string) (*CampaignAutomationResponse, *Response, error) {
+	u := "campaigns/" + id + "/automation"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &CampaignAutomationResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
@@ -0,0 +1,232 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCampaignsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/campaigns/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"campaign": {
+					"type": "single",
+					"userid": "1",
+					"segmentid": "0",
+					"name": "June Newsletter",
+					"cdate": "2020-06-01T09:00:00-05:00",
+					"mdate": "2020-06-02T09:00:00-05:00",
+					"sdate": "2020-06-02T10:00:00-05:00",
+					"ldate": "2020-06-02T10:05:00-05:00",
+					"send_amt": "1200",
+					"total_amt": "1200",
+					"opens": "640",
+					"uniqueopens": "480",
+					"linkclicks": "150",
+					"uniquelinkclicks": "120",
+					"subscriberclicks": "110",
+					"forwards": "3",
+					"uniqueforwards": "2",
+					"hardbounces": "12",
+					"softbounces": "8",
+					"unsubscribes": "5",
+					"unsubreasons": "1",
+					"updates": "0",
+					"socialshares": "0",
+					"replies": "4",
+					"uniquereplies": 4,
+					"status": "5",
+					"public": "1",
+					"tracklinks": "all",
+					"trackreads": "1",
+					"scheduleddate": null,
+					"links": {
+						"automation": "https://your_base_url.api-us1.com/api/3/campaigns/12/automation",
+						"campaignMessages": "https://your_base_url.api-us1.com/api/3/campaigns/12/campaignMessages",
+						"links": "https://your_base_url.api-us1.com/api/3/campaigns/12/links"
+					},
+					"id": "12",
+					"automation": null
+				}
+			}`)
+	})
+
+	campaign, _, err := c.Campaigns.Retrieve(ctx, "12")
+	if err != nil {
+		t.Fatalf("Campaigns.Retrieve returned error: %v", err)
+	}
+
+	want := &CampaignResponse{
+		Campaign: &Campaign{
+			Name:             "June Newsletter",
+			Type:             "single",
+			Status:           CampaignStatusCompleted,
+			UserID:           "1",
+			SegmentID:        "0",
+			Public:           "1",
+			Cdate:            "2020-06-01T09:00:00-05:00",
+			Mdate:            "2020-06-02T09:00:00-05:00",
+			Sdate:            "2020-06-02T10:00:00-05:00",
+			Ldate:            "2020-06-02T10:05:00-05:00",
+			SendAmt:          1200,
+			TotalAmt:         1200,
+			Opens:            640,
+			UniqueOpens:      480,
+			LinkClicks:       150,
+			UniqueLinkClicks: 120,
+			SubscriberClicks: 110,
+			Forwards:         3,
+			UniqueForwards:   2,
+			HardBounces:      12,
+			SoftBounces:      8,
+			Unsubscribes:     5,
+			UnsubReasons:     1,
+			Replies:          4,
+			UniqueReplies:    4,
+			TrackLinks:       "all",
+			TrackReads:       "1",
+			Links: &CampaignLinks{
+				Automation:       "https://your_base_url.api-us1.com/api/3/campaigns/12/automation",
+				CampaignMessages: "https://your_base_url.api-us1.com/api/3/campaigns/12/campaignMessages",
+				Links:            "https://your_base_url.api-us1.com/api/3/campaigns/12/links",
+			},
+			ID: "12",
+		},
+	}
+	if !reflect.DeepEqual(campaign, want) {
+		t.Errorf("Campaigns.Retrieve returned %+v, want %+v", campaign, want)
+	}
+}
+
+func TestCampaign_UnmarshalJSON_invalidCounter(t *testing.T) {
+	var campaign Campaign
+	err := json.Unmarshal([]byte(`{"name": "June Newsletter", "opens": "many"}`), &campaign)
+	if err == nil {
+		t.Errorf("json.Unmarshal of an invalid counter returned no error")
+	}
+}
+
+func TestCampaignsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"orders[sdate]": "DESC", "limit": "2"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"campaigns": [
+					{"name": "June Newsletter", "status": "5", "send_amt": "1200", "opens": "640", "id": "12"},
+					{"name": "July Newsletter", "status": "0", "send_amt": "0", "opens": "0", "id": "13"}
+				],
+				"meta": {"total": "5"}
+			}`)
+	})
+
+	campaigns, resp, err := c.Campaigns.List(ctx, &ListCampaignsOptions{ListOptions: ListOptions{Limit: 2}, OrderBySdate: "DESC"})
+	if err != nil {
+		t.Fatalf("Campaigns.List returned error: %v", err)
+	}
+
+	want := &ListCampaignsResponse{
+		Campaigns: []*Campaign{
+			{Name: "June Newsletter", Status: CampaignStatusCompleted, SendAmt: 1200, Opens: 640, ID: "12"},
+			{Name: "July Newsletter", Status: CampaignStatusDraft, ID: "13"},
+		},
+		Meta: &Meta{Total: "5"},
+	}
+	if !reflect.DeepEqual(campaigns, want) {
+		t.Errorf("Campaigns.List returned %+v, want %+v", campaigns, want)
+	}
+	if resp.Total != 5 || resp.NextOffset != 2 {
+		t.Errorf("Campaigns.List returned Total %d and NextOffset %d, want 5 and 2", resp.Total, resp.NextOffset)
+	}
+}
+
+func TestCampaignsService_ListMessages(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/campaigns/12/campaignMessages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"campaignMessages": [{"campaignid": "12", "messageid": "30", "percentage": "100", "send_amt": "1200", "id": "40"}]}`)
+	})
+
+	messages, _, err := c.Campaigns.ListMessages(ctx, "12")
+	if err != nil {
+		t.Fatalf("Campaigns.ListMessages returned error: %v", err)
+	}
+
+	want := &ListCampaignMessagesResponse{
+		CampaignMessages: []*CampaignMessage{{CampaignID: "12", MessageID: "30", Percentage: "100", SendAmt: "1200", ID: "40"}},
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("Campaigns.ListMessages returned %+v, want %+v", messages, want)
+	}
+}
+
+func TestCampaignsService_ListLinks(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/campaigns/12/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"links": [{"campaignid": "12", "messageid": "30", "link": "https://www.example.com", "name": "Example", "tracked": "1", "id": "50"}]}`)
+	})
+
+	links, _, err := c.Campaigns.ListLinks(ctx, "12")
+	if err != nil {
+		t.Fatalf("Campaigns.ListLinks returned error: %v", err)
+	}
+
+	want := &ListCampaignLinksResponse{
+		Links: []*CampaignLink{{CampaignID: "12", MessageID: "30", Link: "https://www.example.com", Name: "Example", Tracked: "1", ID: "50"}},
+	}
+	if !reflect.DeepEqual(links, want) {
+		t.Errorf("Campaigns.ListLinks returned %+v, want %+v", links, want)
+	}
+}
+
+func TestCampaignsService_RetrieveAutomation(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/campaigns/12/automation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"automation": {"name": "Onboarding", "status": "1", "id": "4"}}`)
+	})
+
+	automation, _, err := c.Campaigns.RetrieveAutomation(ctx, "12")
+	if err != nil {
+		t.Fatalf("Campaigns.RetrieveAutomation returned error: %v", err)
+	}
+
+	want := &CampaignAutomationResponse{Automation: &Automation{Name: "Onboarding", Status: AutomationStatusActive, ID: "4"}}
+	if !reflect.DeepEqual(automation, want) {
+		t.Errorf("Campaigns.RetrieveAutomation returned %+v, want %+v", automation, want)
+	}
+}
+
+func TestCampaignStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status CampaignStatus `json:"status"`
+	}
+	for in, want := range map[string]CampaignStatus{`{"status": 5}`: CampaignStatusCompleted, `{"status": "5"}`: CampaignStatusCompleted, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
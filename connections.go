@@ -0,0 +1,206 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// ConnectionsService handles communication with Deep Data connection related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#connections
+type ConnectionsService service
+
+// ConnectionStatus is whether a connection is working.
+type ConnectionStatus string
+
+const (
+	ConnectionStatusError     ConnectionStatus = "0"
+	ConnectionStatusConnected ConnectionStatus = "1"
+)
+
+// String returns a readable name for the status.
+func (s ConnectionStatus) String() string {
+	switch s {
+	case ConnectionStatusError:
+		return "error"
+	case ConnectionStatusConnected:
+		return "connected"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes a connection status. See unmarshalStatus.
+func (s *ConnectionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// ConnectionSyncStatus is whether a connection is currently syncing data to Active Campaign.
+type ConnectionSyncStatus string
+
+const (
+	ConnectionSyncStopped ConnectionSyncStatus = "0"
+	ConnectionSyncRunning ConnectionSyncStatus = "1"
+)
+
+// String returns a readable name for the sync status.
+func (s ConnectionSyncStatus) String() string {
+	switch s {
+	case ConnectionSyncStopped:
+		return "stopped"
+	case ConnectionSyncRunning:
+		return "running"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes a connection sync status. See unmarshalStatus.
+func (s *ConnectionSyncStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// Connection links an e-commerce service, such as a storefront, to Active Campaign for Deep Data.
+type Connection struct {
+	// Service is the name of the e-commerce service, and ExternalID identifies the store within it.
+	Service    string               `json:"service,omitempty"`
+	ExternalID string               `json:"externalid,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	LogoURL    string               `json:"logoUrl,omitempty"`
+	LinkURL    string               `json:"linkUrl,omitempty"`
+	Status     ConnectionStatus     `json:"status,omitempty"`
+	SyncStatus ConnectionSyncStatus `json:"syncStatus,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	IsInternal string           `json:"isInternal,omitempty"`
+	Cdate      string           `json:"cdate,omitempty"`
+	Udate      string           `json:"udate,omitempty"`
+	Links      *ConnectionLinks `json:"links,omitempty"`
+	ID         string           `json:"id,omitempty"`
+}
+
+// ConnectionLinks are the related resource URLs returned with a connection.
+type ConnectionLinks struct {
+	Options   string `json:"options"`
+	Customers string `json:"customers"`
+}
+
+// CreateConnectionRequest is the request body used for creating a connection.
+type CreateConnectionRequest struct {
+	Connection *Connection `json:"connection"`
+}
+
+// UpdateConnectionRequest is the request body used for updating a connection.
+type UpdateConnectionRequest struct {
+	Connection *Connection `json:"connection"`
+}
+
+// ConnectionResponse is the response body returned from creating, retrieving or updating a connection.
+type ConnectionResponse struct {
+	Connection *Connection `json:"connection"`
+}
+
+// ListConnectionsOptions specifies the optional parameters to ConnectionsService.List.
+type ListConnectionsOptions struct {
+	ListOptions
+
+	Service    string `url:"filters[service],omitempty"`
+	ExternalID string `url:"filters[externalid],omitempty"`
+}
+
+// ListConnectionsResponse is the response body returned from listing connections.
+type ListConnectionsResponse struct {
+	Connections []*Connection `json:"connections"`
+	Meta        *Meta         `json:"meta"`
+}
+
+// Create a connection.
+func (s *ConnectionsService) Create(ctx context.Context, connection *CreateConnectionRequest) (*ConnectionResponse, *Response, error) {
+	u := "connections"
+	req, err := s.client.NewRequest(http.MethodPost, u, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ConnectionResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a connection.
+func (s *ConnectionsService) Retrieve(ctx context.Context, id string) (*ConnectionResponse, *Response, error) {
+	u := "connections/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ConnectionResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a connection.
+func (s *ConnectionsService) Update(ctx context.Context, id string, connection *UpdateConnectionRequest) (*ConnectionResponse, *Response, error) {
+	u := "connections/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ConnectionResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// SetSyncStatus updates the sync status of a connection, for example to report that a sync has started or stopped.
+func (s *ConnectionsService) SetSyncStatus(ctx context.Context, id string, syncStatus ConnectionSyncStatus) (*ConnectionResponse, *Response, error) {
+	return s.Update(ctx, id, &UpdateConnectionRequest{Connection: &Connection{SyncStatus: syncStatus}})
+}
+
+// Delete a connection, along with its Deep Data customers and orders.
+func (s *ConnectionsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "connections/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List connections, one page at a time. Use ListAllPages to walk every page.
+func (s *ConnectionsService) List(ctx context.Context, opts *ListConnectionsOptions) (*ListConnectionsResponse, *Response, error) {
+	u, err := addOptions("connections", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListConnectionsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
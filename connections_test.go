@@ -0,0 +1,233 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestConnectionsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateConnectionRequest{
+		&Connection{
+			Service:    "example-store",
+			ExternalID: "store-1",
+			Name:       "Example Store",
+			LogoURL:    "https://www.example.com/logo.png",
+			LinkURL:    "https://www.example.com",
+		},
+	}
+
+	mux.HandleFunc("/api/3/connections", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateConnectionRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"connection": {
+					"isInternal": "0",
+					"service": "example-store",
+					"externalid": "store-1",
+					"name": "Example Store",
+					"logoUrl": "https://www.example.com/logo.png",
+					"linkUrl": "https://www.example.com",
+					"status": 1,
+					"syncStatus": 0,
+					"cdate": "2020-06-24T12:00:00-05:00",
+					"udate": "2020-06-24T12:00:00-05:00",
+					"links": {
+						"options": "https://your_base_url.api-us1.com/api/3/connections/1/options",
+						"customers": "https://your_base_url.api-us1.com/api/3/connections/1/customers"
+					},
+					"id": "1"
+				}
+			}`)
+	})
+
+	connection, _, err := c.Connections.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Connections.Create returned error: %v", err)
+	}
+
+	want := &ConnectionResponse{
+		Connection: &Connection{
+			Service:    "example-store",
+			ExternalID: "store-1",
+			Name:       "Example Store",
+			LogoURL:    "https://www.example.com/logo.png",
+			LinkURL:    "https://www.example.com",
+			Status:     ConnectionStatusConnected,
+			SyncStatus: ConnectionSyncStopped,
+			IsInternal: "0",
+			Cdate:      "2020-06-24T12:00:00-05:00",
+			Udate:      "2020-06-24T12:00:00-05:00",
+			Links: &ConnectionLinks{
+				Options:   "https://your_base_url.api-us1.com/api/3/connections/1/options",
+				Customers: "https://your_base_url.api-us1.com/api/3/connections/1/customers",
+			},
+			ID: "1",
+		},
+	}
+	if !reflect.DeepEqual(connection, want) {
+		t.Errorf("Connections.Create returned %+v, want %+v", connection, want)
+	}
+}
+
+func TestConnectionsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/connections/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"connection": {"service": "example-store", "externalid": "store-1", "status": "0", "syncStatus": "1", "id": "1"}}`)
+	})
+
+	connection, _, err := c.Connections.Retrieve(ctx, "1")
+	if err != nil {
+		t.Fatalf("Connections.Retrieve returned error: %v", err)
+	}
+
+	want := &ConnectionResponse{
+		Connection: &Connection{Service: "example-store", ExternalID: "store-1", Status: ConnectionStatusError, SyncStatus: ConnectionSyncRunning, ID: "1"},
+	}
+	if !reflect.DeepEqual(connection, want) {
+		t.Errorf("Connections.Retrieve returned %+v, want %+v", connection, want)
+	}
+	if got := connection.Connection.SyncStatus.String(); got != "running" {
+		t.Errorf("Connection sync status String() = %q, want %q", got, "running")
+	}
+}
+
+func TestConnectionsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateConnectionRequest{&Connection{Name: "Example Shop", Status: ConnectionStatusConnected}}
+
+	mux.HandleFunc("/api/3/connections/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateConnectionRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"connection": {"name": "Example Shop", "status": "1", "id": "1"}}`)
+	})
+
+	connection, _, err := c.Connections.Update(ctx, "1", input)
+	if err != nil {
+		t.Fatalf("Connections.Update returned error: %v", err)
+	}
+
+	want := &ConnectionResponse{Connection: &Connection{Name: "Example Shop", Status: ConnectionStatusConnected, ID: "1"}}
+	if !reflect.DeepEqual(connection, want) {
+		t.Errorf("Connections.Update returned %+v, want %+v", connection, want)
+	}
+}
+
+func TestConnectionsService_SetSyncStatus(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/connections/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateConnectionRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		want := &UpdateConnectionRequest{&Connection{SyncStatus: ConnectionSyncRunning}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"connection": {"syncStatus": 1, "id": "1"}}`)
+	})
+
+	connection, _, err := c.Connections.SetSyncStatus(ctx, "1", ConnectionSyncRunning)
+	if err != nil {
+		t.Fatalf("Connections.SetSyncStatus returned error: %v", err)
+	}
+	if connection.Connection.SyncStatus != ConnectionSyncRunning {
+		t.Errorf("Connections.SetSyncStatus returned sync status %v, want %v", connection.Connection.SyncStatus, ConnectionSyncRunning)
+	}
+}
+
+func TestConnectionsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/connections/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Connections.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Connections.Delete returned error: %v", err)
+	}
+}
+
+func TestConnectionsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/connections", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[service]": "example-store", "filters[externalid]": "store-1"})
+		_, _ = fmt.Fprint(w, `{"connections": [{"service": "example-store", "externalid": "store-1", "status": "1", "id": "1"}], "meta": {"total": "1"}}`)
+	})
+
+	connections, _, err := c.Connections.List(ctx, &ListConnectionsOptions{Service: "example-store", ExternalID: "store-1"})
+	if err != nil {
+		t.Fatalf("Connections.List returned error: %v", err)
+	}
+
+	want := &ListConnectionsResponse{
+		Connections: []*Connection{{Service: "example-store", ExternalID: "store-1", Status: ConnectionStatusConnected, ID: "1"}},
+		Meta:        &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(connections, want) {
+		t.Errorf("Connections.List returned %+v, want %+v", connections, want)
+	}
+}
+
+func TestConnectionStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status ConnectionStatus `json:"status"`
+	}
+	for in, want := range map[string]ConnectionStatus{`{"status": 1}`: ConnectionStatusConnected, `{"status": "1"}`: ConnectionStatusConnected, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
+
+func TestConnectionSyncStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status ConnectionSyncStatus `json:"status"`
+	}
+	for in, want := range map[string]ConnectionSyncStatus{`{"status": 1}`: ConnectionSyncRunning, `{"status": "1"}`: ConnectionSyncRunning, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
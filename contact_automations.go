@@ -0,0 +1,176 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Contact Automations are part of the Automations Service.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#contact-automation
+
+// EnrollmentStatus is the progress of a contact through an automation.
+type EnrollmentStatus string
+
+const (
+	EnrollmentStatusActive    EnrollmentStatus = "1"
+	EnrollmentStatusCompleted EnrollmentStatus = "2"
+)
+
+// String returns a readable name for the status.
+func (s EnrollmentStatus) String() string {
+	switch s {
+	case EnrollmentStatusActive:
+		return "active"
+	case EnrollmentStatusCompleted:
+		return "completed"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes an enrollment status. See unmarshalStatus.
+func (s *EnrollmentStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// ContactAutomation is the enrollment of a contact in an automation.
+type ContactAutomation struct {
+	Contact    string `json:"contact"`
+	Automation string `json:"automation,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	SeriesID  string           `json:"seriesid,omitempty"`
+	StartID   json.Number      `json:"startid,omitempty"`
+	Status    EnrollmentStatus `json:"status,omitempty"`
+	AddDate   string           `json:"adddate,omitempty"`
+	RemDate   string           `json:"remdate,omitempty"`
+	Timespan  string           `json:"timespan,omitempty"`
+	LastBlock string           `json:"lastblock,omitempty"`
+	LastDate  string           `json:"lastdate,omitempty"`
+	// CompletedElements of TotalElements blocks have been run, CompleteValue percent of the automation.
+	CompletedElements json.Number             `json:"completedElements,omitempty"`
+	TotalElements     json.Number             `json:"totalElements,omitempty"`
+	Completed         json.Number             `json:"completed,omitempty"`
+	CompleteValue     json.Number             `json:"completeValue,omitempty"`
+	Links             *ContactAutomationLinks `json:"links,omitempty"`
+	ID                string                  `json:"id,omitempty"`
+}
+
+// ContactAutomationLinks are the related resource URLs returned with a contact automation.
+type ContactAutomationLinks struct {
+	Automation   string `json:"automation"`
+	Contact      string `json:"contact"`
+	ContactGoals string `json:"contactGoals"`
+}
+
+// AddContactToAutomationRequest is the request body used for adding a contact to an automation.
+type AddContactToAutomationRequest struct {
+	ContactAutomation *ContactAutomation `json:"contactAutomation"`
+}
+
+// ContactAutomationResponse is the response body returned from adding a contact to an automation,
+// or retrieving the enrollment.
+type ContactAutomationResponse struct {
+	Contacts          []*Contact         `json:"contacts,omitempty"`
+	ContactAutomation *ContactAutomation `json:"contactAutomation"`
+}
+
+// ListContactAutomationsResponse is the response body returned from listing contact automations.
+type ListContactAutomationsResponse struct {
+	ContactAutomations []*ContactAutomation `json:"contactAutomations"`
+}
+
+// AddContact adds a contact to an automation.
+func (s *AutomationsService) AddContact(ctx context.Context, contactAutomation *AddContactToAutomationRequest) (*ContactAutomationResponse, *Response, error) {
+	u := "contactAutomations"
+	req, err := s.client.NewRequest(http.MethodPost, u, contactAutomation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ContactAutomationResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Enroll adds the contact with the given ID to the automation with the given ID.
+func (s *AutomationsService) Enroll(ctx context.Context, contactID, automationID string) (*ContactAutomationResponse, *Response, error) {
+	return s.AddContact(ctx, &AddContactToAutomationRequest{
+		ContactAutomation: &ContactAutomation{Contact: contactID, Automation: automationID},
+	})
+}
+
+// RetrieveContactAutomation retrieves the enrollment of a contact in an automation.
+func (s *AutomationsService) RetrieveContactAutomation(ctx context.Context, id string) (*ContactAutomationResponse, *Response, error) {
+	u := "contactAutomations/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ContactAutomationResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// ListContactAutomations lists the automations a contact is, or has been, enrolled in.
+func (s *AutomationsService) ListContactAutomations(ctx context.Context, contactID string) (*ListContactAutomationsResponse, *Response, error) {
+	u := "contacts/" + contactID + "/contactAutomations"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListContactAutomationsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RemoveContact removes a contact from an automation, given the ID of the enrollment.
+func (s *AutomationsService) RemoveContact(ctx context.Context, contactAutomationID string) (*Response, error) {
+	u := "contactAutomations/" + contactAutomationID
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// Unenroll removes the contact with the given ID from every active enrollment in the automation with the given ID.
+// If the contact is not active in the automation, nothing is removed and a nil Response is returned.
+func (s *AutomationsService) Unenroll(ctx context.Context, contactID, automationID string) (*Response, error) {
+	contactAutomations, _, err := s.ListContactAutomations(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Response
+	for _, ca := range contactAutomations.ContactAutomations {
+		if ca.Automation != automationID || ca.Status != EnrollmentStatusActive {
+			continue
+		}
+		resp, err = s.RemoveContact(ctx, ca.ID)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,223 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAutomationsService_Enroll(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contactAutomations", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddContactToAutomationRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &AddContactToAutomationRequest{&ContactAutomation{Contact: "7", Automation: "4"}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contacts": [{"email": "johndoe@example.com", "id": "7"}],
+				"contactAutomation": {
+					"contact": "7",
+					"seriesid": "4",
+					"startid": 0,
+					"status": 1,
+					"adddate": "2020-06-24T12:00:00-05:00",
+					"remdate": null,
+					"timespan": null,
+					"lastblock": "0",
+					"lastdate": "2020-06-24T12:00:00-05:00",
+					"completedElements": 0,
+					"totalElements": 5,
+					"completed": 0,
+					"completeValue": 0,
+					"links": {
+						"automation": "https://your_base_url.api-us1.com/api/3/contactAutomations/9/automation",
+						"contact": "https://your_base_url.api-us1.com/api/3/contactAutomations/9/contact",
+						"contactGoals": "https://your_base_url.api-us1.com/api/3/contactAutomations/9/contactGoals"
+					},
+					"id": "9",
+					"automation": "4"
+				}
+			}`)
+	})
+
+	enrollment, _, err := c.Automations.Enroll(ctx, "7", "4")
+	if err != nil {
+		t.Fatalf("Automations.Enroll returned error: %v", err)
+	}
+
+	want := &ContactAutomation{
+		Contact:           "7",
+		Automation:        "4",
+		SeriesID:          "4",
+		StartID:           "0",
+		Status:            EnrollmentStatusActive,
+		AddDate:           "2020-06-24T12:00:00-05:00",
+		LastBlock:         "0",
+		LastDate:          "2020-06-24T12:00:00-05:00",
+		CompletedElements: "0",
+		TotalElements:     "5",
+		Completed:         "0",
+		CompleteValue:     "0",
+		Links: &ContactAutomationLinks{
+			Automation:   "https://your_base_url.api-us1.com/api/3/contactAutomations/9/automation",
+			Contact:      "https://your_base_url.api-us1.com/api/3/contactAutomations/9/contact",
+			ContactGoals: "https://your_base_url.api-us1.com/api/3/contactAutomations/9/contactGoals",
+		},
+		ID: "9",
+	}
+	if !reflect.DeepEqual(enrollment.ContactAutomation, want) {
+		t.Errorf("Automations.Enroll returned %+v, want %+v", enrollment.ContactAutomation, want)
+	}
+}
+
+func TestAutomationsService_RetrieveContactAutomation(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contactAutomations/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"contactAutomation": {"contact": "7", "automation": "4", "status": "2", "completed": 1, "completeValue": 100, "id": "9"}}`)
+	})
+
+	enrollment, _, err := c.Automations.RetrieveContactAutomation(ctx, "9")
+	if err != nil {
+		t.Fatalf("Automations.RetrieveContactAutomation returned error: %v", err)
+	}
+
+	want := &ContactAutomationResponse{
+		ContactAutomation: &ContactAutomation{
+			Contact:       "7",
+			Automation:    "4",
+			Status:        EnrollmentStatusCompleted,
+			Completed:     "1",
+			CompleteValue: "100",
+			ID:            "9",
+		},
+	}
+	if !reflect.DeepEqual(enrollment, want) {
+		t.Errorf("Automations.RetrieveContactAutomation returned %+v, want %+v", enrollment, want)
+	}
+	if got := enrollment.ContactAutomation.Status.String(); got != "completed" {
+		t.Errorf("Enrollment status String() = %q, want %q", got, "completed")
+	}
+}
+
+func TestAutomationsService_ListContactAutomations(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/7/contactAutomations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"contactAutomations": [{"contact": "7", "automation": "4", "status": "1", "id": "9"}]}`)
+	})
+
+	enrollments, _, err := c.Automations.ListContactAutomations(ctx, "7")
+	if err != nil {
+		t.Fatalf("Automations.ListContactAutomations returned error: %v", err)
+	}
+
+	want := &ListContactAutomationsResponse{
+		ContactAutomations: []*ContactAutomation{{Contact: "7", Automation: "4", Status: EnrollmentStatusActive, ID: "9"}},
+	}
+	if !reflect.DeepEqual(enrollments, want) {
+		t.Errorf("Automations.ListContactAutomations returned %+v, want %+v", enrollments, want)
+	}
+}
+
+func TestAutomationsService_RemoveContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contactAutomations/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Automations.RemoveContact(ctx, "9")
+	if err != nil {
+		t.Errorf("Automations.RemoveContact returned error: %v", err)
+	}
+}
+
+func TestAutomationsService_Unenroll(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/7/contactAutomations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contactAutomations": [
+					{"contact": "7", "automation": "4", "status": "2", "id": "8"},
+					{"contact": "7", "automation": "4", "status": "1", "id": "9"},
+					{"contact": "7", "automation": "5", "status": "1", "id": "10"}
+				]
+			}`)
+	})
+	var removed []string
+	for _, id := range []string{"8", "9", "10"} {
+		id := id
+		mux.HandleFunc("/api/3/contactAutomations/"+id, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			removed = append(removed, id)
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+	}
+
+	resp, err := c.Automations.Unenroll(ctx, "7", "4")
+	if err != nil {
+		t.Fatalf("Automations.Unenroll returned error: %v", err)
+	}
+	if resp == nil {
+		t.Errorf("Automations.Unenroll returned a nil Response after removing the contact")
+	}
+	if want := []string{"9"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("Automations.Unenroll removed %v, want %v", removed, want)
+	}
+}
+
+func TestAutomationsService_Unenroll_notEnrolled(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/7/contactAutomations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"contactAutomations": [{"contact": "7", "automation": "4", "status": "2", "id": "8"}]}`)
+	})
+	mux.HandleFunc("/api/3/contactAutomations/8", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Automations.Unenroll removed a completed enrollment")
+	})
+
+	resp, err := c.Automations.Unenroll(ctx, "7", "4")
+	if err != nil {
+		t.Fatalf("Automations.Unenroll returned error: %v", err)
+	}
+	if resp != nil {
+		t.Errorf("Automations.Unenroll returned %+v, want a nil Response", resp)
+	}
+}
+
+func TestEnrollmentStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status EnrollmentStatus `json:"status"`
+	}
+	for in, want := range map[string]EnrollmentStatus{`{"status": 2}`: EnrollmentStatusCompleted, `{"status": "2"}`: EnrollmentStatusCompleted, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package active_campaign
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -10,49 +12,88 @@ import (
 // Active Campaign API docs: https://developers.activecampaign.com/reference#contact
 type ContactsService service
 
+// Contact is a person in Active Campaign. The same model is used when creating, updating and retrieving contacts;
+// read-only fields are ignored by Active Campaign when sent in a request.
 type Contact struct {
-	Email     string `json:"email"`
+	Email     string `json:"email,omitempty"`
 	FirstName string `json:"firstName,omitempty"`
 	LastName  string `json:"lastName,omitempty"`
 	Phone     string `json:"phone,omitempty"`
+
+	// FieldValues sets custom field values inline when creating or syncing a contact.
+	// Only Field and Value need to be set.
+	FieldValues []*FieldValue `json:"fieldValues,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate               string        `json:"cdate,omitempty"`
+	Udate               string        `json:"udate,omitempty"`
+	Adate               string        `json:"adate,omitempty"`
+	Edate               string        `json:"edate,omitempty"`
+	Orgid               string        `json:"orgid,omitempty"`
+	Orgname             string        `json:"orgname,omitempty"`
+	Organization        string        `json:"organization,omitempty"`
+	SegmentioID         string        `json:"segmentio_id,omitempty"`
+	BouncedHard         string        `json:"bounced_hard,omitempty"`
+	BouncedSoft         string        `json:"bounced_soft,omitempty"`
+	BouncedDate         string        `json:"bounced_date,omitempty"`
+	IP                  string        `json:"ip,omitempty"`
+	Ua                  string        `json:"ua,omitempty"`
+	Hash                string        `json:"hash,omitempty"`
+	SocialdataLastcheck string        `json:"socialdata_lastcheck,omitempty"`
+	EmailLocal          string        `json:"email_local,omitempty"`
+	EmailDomain         string        `json:"email_domain,omitempty"`
+	EmailEmpty          bool          `json:"email_empty,omitempty"`
+	Sentcnt             string        `json:"sentcnt,omitempty"`
+	RatingTstamp        string        `json:"rating_tstamp,omitempty"`
+	Gravatar            string        `json:"gravatar,omitempty"`
+	Deleted             string        `json:"deleted,omitempty"`
+	Anonymized          string        `json:"anonymized,omitempty"`
+	DeletedAt           string        `json:"deleted_at,omitempty"`
+	CreatedUtcTimestamp string        `json:"created_utc_timestamp,omitempty"`
+	UpdatedUtcTimestamp string        `json:"updated_utc_timestamp,omitempty"`
+	CreatedTimestamp    string        `json:"created_timestamp,omitempty"`
+	UpdatedTimestamp    string        `json:"updated_timestamp,omitempty"`
+	CreatedBy           string        `json:"created_by,omitempty"`
+	UpdatedBy           string        `json:"updated_by,omitempty"`
+	AccountContacts     []interface{} `json:"accountContacts,omitempty"`
+	Links               *ContactLinks `json:"links,omitempty"`
+	ID                  string        `json:"id,omitempty"`
 }
 
-type CreateContactRequest struct {
-	Contact *Contact `json:"contact"`
+// ContactLinks are the related resource URLs returned with a contact.
+type ContactLinks struct {
+	BounceLogs            string `json:"bounceLogs"`
+	ContactAutomations    string `json:"contactAutomations"`
+	ContactData           string `json:"contactData"`
+	ContactGoals          string `json:"contactGoals"`
+	ContactLists          string `json:"contactLists"`
+	ContactLogs           string `json:"contactLogs"`
+	ContactTags           string `json:"contactTags"`
+	ContactDeals          string `json:"contactDeals"`
+	Deals                 string `json:"deals"`
+	FieldValues           string `json:"fieldValues"`
+	GeoIps                string `json:"geoIps"`
+	Notes                 string `json:"notes"`
+	Organization          string `json:"organization"`
+	PlusAppend            string `json:"plusAppend"`
+	TrackingLogs          string `json:"trackingLogs"`
+	ScoreValues           string `json:"scoreValues"`
+	AccountContacts       string `json:"accountContacts"`
+	AutomationEntryCounts string `json:"automationEntryCounts"`
 }
 
-type CreatedContact struct {
-	Email string `json:"email"`
-	Cdate string `json:"cdate"`
-	Udate string `json:"udate"`
-	Orgid string `json:"orgid"`
-	Links struct {
-		BounceLogs         string `json:"bounceLogs"`
-		ContactAutomations string `json:"contactAutomations"`
-		ContactData        string `json:"contactData"`
-		ContactGoals       string `json:"contactGoals"`
-		ContactLists       string `json:"contactLists"`
-		ContactLogs        string `json:"contactLogs"`
-		ContactTags        string `json:"contactTags"`
-		ContactDeals       string `json:"contactDeals"`
-		Deals              string `json:"deals"`
-		FieldValues        string `json:"fieldValues"`
-		GeoIps             string `json:"geoIps"`
-		Notes              string `json:"notes"`
-		Organization       string `json:"organization"`
-		PlusAppend         string `json:"plusAppend"`
-		TrackingLogs       string `json:"trackingLogs"`
-		ScoreValues        string `json:"scoreValues"`
-	} `json:"links"`
-	ID           string `json:"id"`
-	Organization string `json:"organization"`
+// CreateContactRequest is the request body used for creating a contact.
+type CreateContactRequest struct {
+	Contact *Contact `json:"contact"`
 }
 
+// CreateContactResponse is the response body from creating a contact.
 type CreateContactResponse struct {
-	Contact *CreatedContact `json:"contact"`
+	Contact *Contact `json:"contact"`
 }
 
-func (s *ContactsService) Create(contact *CreateContactRequest) (*CreateContactResponse, *Response, error) {
+// Create a contact.
+func (s *ContactsService) Create(ctx context.Context, contact *CreateContactRequest) (*CreateContactResponse, *Response, error) {
 	u := "contacts"
 	req, err := s.client.NewRequest(http.MethodPost, u, contact)
 	if err != nil {
@@ -60,7 +101,7 @@ func (s *ContactsService) Create(contact *CreateContactRequest) (*CreateContactR
 	}
 
 	c := &CreateContactResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -69,116 +110,255 @@ func (s *ContactsService) Create(contact *CreateContactRequest) (*CreateContactR
 	return c, resp, nil
 }
 
+// SyncContactRequest is the request body used for syncing a contact.
+type SyncContactRequest struct {
+	Contact *Contact `json:"contact"`
+}
+
+// SyncContactResponse is the response body from syncing a contact.
+type SyncContactResponse struct {
+	Contact     *Contact      `json:"contact"`
+	FieldValues []*FieldValue `json:"fieldValues,omitempty"`
+
+	// Created is true if the sync created a new contact, and false if it updated an existing one.
+	Created bool `json:"-"`
+}
+
+// Sync creates a contact, or updates the existing contact with the same email address.
+func (s *ContactsService) Sync(ctx context.Context, contact *SyncContactRequest) (*SyncContactResponse, *Response, error) {
+	u := "contact/sync"
+	req, err := s.client.NewRequest(http.MethodPost, u, contact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &SyncContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Active Campaign responds with 201 Created for new contacts and 200 OK for updated ones.
+	c.Created = resp.StatusCode == http.StatusCreated
+
+	return c, resp, nil
+}
+
+// ContactResponse is the response body from retrieving or updating a contact.
+// Related resources are only included when retrieving.
+type ContactResponse struct {
+	Contact      *Contact       `json:"contact"`
+	ContactLists []*ContactList `json:"contactLists,omitempty"`
+	ContactTags  []*ContactTag  `json:"contactTags,omitempty"`
+	FieldValues  []*FieldValue  `json:"fieldValues,omitempty"`
+}
+
+// Retrieve a contact.
+func (s *ContactsService) Retrieve(ctx context.Context, id string) (*ContactResponse, *Response, error) {
+	u := "contacts/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateContactRequest is the request body used for updating a contact.
+type UpdateContactRequest struct {
+	Contact *Contact `json:"contact"`
+}
+
+// Update a contact. Only the fields set on the request contact are changed.
+func (s *ContactsService) Update(ctx context.Context, id string, contact *UpdateContactRequest) (*ContactResponse, *Response, error) {
+	u := "contacts/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, contact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ContactResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a contact.
+func (s *ContactsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "contacts/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.client.cache.forgetContact(id)
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListContactsOptions specifies the optional parameters to ContactsService.List.
+type ListContactsOptions struct {
+	ListOptions
+
+	// Email filters contacts by exact email address.
+	Email string `url:"email,omitempty"`
+	// EmailLike filters contacts whose email address contains the given value.
+	EmailLike string `url:"email_like,omitempty"`
+	// Search filters contacts by name, organization, phone or email.
+	Search    string `url:"search,omitempty"`
+	ListID    string `url:"listid,omitempty"`
+	TagID     string `url:"tagid,omitempty"`
+	SegmentID string `url:"segmentid,omitempty"`
+	FormID    string `url:"formid,omitempty"`
+	SeriesID  string `url:"seriesid,omitempty"`
+	// Status filters contacts by their status on a list: -1 any, 0 unconfirmed, 1 active, 2 unsubscribed, 3 bounced.
+	Status string `url:"status,omitempty"`
+
+	// Date filters accept a date (YYYY-MM-DD) or a datetime.
+	CreatedBefore string `url:"filters[created_before],omitempty"`
+	CreatedAfter  string `url:"filters[created_after],omitempty"`
+	UpdatedBefore string `url:"filters[updated_before],omitempty"`
+	UpdatedAfter  string `url:"filters[updated_after],omitempty"`
+
+	// Orders sort the results. Each accepts "ASC" or "DESC".
+	OrderByBounces   string `url:"orders[bounces],omitempty"`
+	OrderByCdate     string `url:"orders[cdate],omitempty"`
+	OrderByEmail     string `url:"orders[email],omitempty"`
+	OrderByFirstName string `url:"orders[first_name],omitempty"`
+	OrderByLastName  string `url:"orders[last_name],omitempty"`
+	OrderByName      string `url:"orders[name],omitempty"`
+	OrderByScore     string `url:"orders[score],omitempty"`
+}
+
+// ListContactsResponse is the response body from listing contacts.
+type ListContactsResponse struct {
+	Contacts []*Contact `json:"contacts"`
+	Meta     *Meta      `json:"meta"`
+}
+
+// List contacts, one page at a time. Use ListAllPages to walk every page.
+func (s *ContactsService) List(ctx context.Context, opts *ListContactsOptions) (*ListContactsResponse, *Response, error) {
+	u, err := addOptions("contacts", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListContactsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// ListStatus is the subscription status of a contact on a list.
+type ListStatus string
+
+const (
+	ListStatusUnconfirmed  ListStatus = "0"
+	ListStatusActive       ListStatus = "1"
+	ListStatusUnsubscribed ListStatus = "2"
+	ListStatusBounced      ListStatus = "3"
+)
+
+// String returns a readable name for the status.
+func (s ListStatus) String() string {
+	switch s {
+	case ListStatusUnconfirmed:
+		return "unconfirmed"
+	case ListStatusActive:
+		return "active"
+	case ListStatusUnsubscribed:
+		return "unsubscribed"
+	case ListStatusBounced:
+		return "bounced"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes a list status. See unmarshalStatus.
+func (s *ListStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// ContactList is the membership of a contact on a list.
 type ContactList struct {
-	List    string `json:"list"`
-	Contact string `json:"contact"`
-	Status  string `json:"status"`
+	List    string     `json:"list"`
+	Contact string     `json:"contact"`
+	Status  ListStatus `json:"status"`
+
+	// Read-only fields returned by Active Campaign.
+	Form        interface{} `json:"form,omitempty"`
+	Seriesid    string      `json:"seriesid,omitempty"`
+	Sdate       string      `json:"sdate,omitempty"`
+	Udate       string      `json:"udate,omitempty"`
+	Responder   string      `json:"responder,omitempty"`
+	Sync        string      `json:"sync,omitempty"`
+	Unsubreason string      `json:"unsubreason,omitempty"`
+	Campaign    interface{} `json:"campaign,omitempty"`
+	Message     interface{} `json:"message,omitempty"`
+	FirstName   string      `json:"first_name,omitempty"`
+	LastName    string      `json:"last_name,omitempty"`
+	IP4Sub      string      `json:"ip4Sub,omitempty"`
+	// Update list status for a contact does not return a uniform type for Sourceid.
+	// If a contact is not a member of the list, it will return a number. Otherwise, a string is returned.
+	Sourceid              interface{}       `json:"sourceid,omitempty"`
+	AutosyncLog           interface{}       `json:"autosyncLog,omitempty"`
+	IP4Last               string            `json:"ip4_last,omitempty"`
+	IP4Unsub              string            `json:"ip4Unsub,omitempty"`
+	CreatedTimestamp      string            `json:"created_timestamp,omitempty"`
+	UpdatedTimestamp      string            `json:"updated_timestamp,omitempty"`
+	CreatedBy             interface{}       `json:"created_by,omitempty"`
+	UpdatedBy             interface{}       `json:"updated_by,omitempty"`
+	UnsubscribeAutomation interface{}       `json:"unsubscribeAutomation,omitempty"`
+	Links                 *ContactListLinks `json:"links,omitempty"`
+	ID                    string            `json:"id,omitempty"`
+	Automation            interface{}       `json:"automation,omitempty"`
+}
+
+// ContactListLinks are the related resource URLs returned with a list membership.
+type ContactListLinks struct {
+	Automation            string `json:"automation"`
+	List                  string `json:"list"`
+	Contact               string `json:"contact"`
+	Form                  string `json:"form"`
+	AutosyncLog           string `json:"autosyncLog"`
+	Campaign              string `json:"campaign"`
+	UnsubscribeAutomation string `json:"unsubscribeAutomation"`
+	Message               string `json:"message"`
 }
 
+// UpdateListStatusForContactRequest is the request body used for updating the status of a contact on a list.
 type UpdateListStatusForContactRequest struct {
 	ContactList *ContactList `json:"contactList"`
 }
 
+// UpdateContactListStatusResponse is the response body from updating the status of a contact on a list.
 type UpdateContactListStatusResponse struct {
-	Contacts []struct {
-		Cdate               string `json:"cdate"`
-		Email               string `json:"email"`
-		Phone               string `json:"phone"`
-		FirstName           string `json:"firstName"`
-		LastName            string `json:"lastName"`
-		Orgid               string `json:"orgid"`
-		Orgname             string `json:"orgname"`
-		SegmentioID         string `json:"segmentio_id"`
-		BouncedHard         string `json:"bounced_hard"`
-		BouncedSoft         string `json:"bounced_soft"`
-		BouncedDate         string `json:"bounced_date"`
-		IP                  string `json:"ip"`
-		Ua                  string `json:"ua"`
-		Hash                string `json:"hash"`
-		SocialdataLastcheck string `json:"socialdata_lastcheck"`
-		EmailLocal          string `json:"email_local"`
-		EmailDomain         string `json:"email_domain"`
-		Sentcnt             string `json:"sentcnt"`
-		RatingTstamp        string `json:"rating_tstamp"`
-		Gravatar            string `json:"gravatar"`
-		Deleted             string `json:"deleted"`
-		Anonymized          string `json:"anonymized"`
-		Adate               string `json:"adate"`
-		Udate               string `json:"udate"`
-		Edate               string `json:"edate"`
-		DeletedAt           string `json:"deleted_at"`
-		CreatedUtcTimestamp string `json:"created_utc_timestamp"`
-		UpdatedUtcTimestamp string `json:"updated_utc_timestamp"`
-		CreatedTimestamp    string `json:"created_timestamp"`
-		UpdatedTimestamp    string `json:"updated_timestamp"`
-		CreatedBy           string `json:"created_by"`
-		UpdatedBy           string `json:"updated_by"`
-		Links               struct {
-			BounceLogs            string `json:"bounceLogs"`
-			ContactAutomations    string `json:"contactAutomations"`
-			ContactData           string `json:"contactData"`
-			ContactGoals          string `json:"contactGoals"`
-			ContactLists          string `json:"contactLists"`
-			ContactLogs           string `json:"contactLogs"`
-			ContactTags           string `json:"contactTags"`
-			ContactDeals          string `json:"contactDeals"`
-			Deals                 string `json:"deals"`
-			FieldValues           string `json:"fieldValues"`
-			GeoIps                string `json:"geoIps"`
-			Notes                 string `json:"notes"`
-			Organization          string `json:"organization"`
-			PlusAppend            string `json:"plusAppend"`
-			TrackingLogs          string `json:"trackingLogs"`
-			ScoreValues           string `json:"scoreValues"`
-			AccountContacts       string `json:"accountContacts"`
-			AutomationEntryCounts string `json:"automationEntryCounts"`
-		} `json:"links"`
-		ID           string      `json:"id"`
-		Organization interface{} `json:"organization"`
-	} `json:"contacts"`
-	ContactList struct {
-		Contact     string      `json:"contact"`
-		List        string      `json:"list"`
-		Form        interface{} `json:"form"`
-		Seriesid    string      `json:"seriesid"`
-		Sdate       string      `json:"sdate"`
-		Udate       interface{} `json:"udate"`
-		Status      string      `json:"status"`
-		Responder   string      `json:"responder"`
-		Sync        string      `json:"sync"`
-		Unsubreason string      `json:"unsubreason"`
-		Campaign    interface{} `json:"campaign"`
-		Message     interface{} `json:"message"`
-		FirstName   string      `json:"first_name"`
-		LastName    string      `json:"last_name"`
-		IP4Sub      string      `json:"ip4Sub"`
-		// Update list status for a contact does not return a uniform type for Sourceid.
-		// If a contact is not a member of the list, it will return a number. Otherwise, a string is returned.
-		Sourceid              interface{} `json:"sourceid,string"`
-		AutosyncLog           interface{} `json:"autosyncLog"`
-		IP4Last               string      `json:"ip4_last"`
-		IP4Unsub              string      `json:"ip4Unsub"`
-		CreatedTimestamp      string      `json:"created_timestamp"`
-		UpdatedTimestamp      string      `json:"updated_timestamp"`
-		CreatedBy             interface{} `json:"created_by"`
-		UpdatedBy             interface{} `json:"updated_by"`
-		UnsubscribeAutomation interface{} `json:"unsubscribeAutomation"`
-		Links                 struct {
-			Automation            string `json:"automation"`
-			List                  string `json:"list"`
-			Contact               string `json:"contact"`
-			Form                  string `json:"form"`
-			AutosyncLog           string `json:"autosyncLog"`
-			Campaign              string `json:"campaign"`
-			UnsubscribeAutomation string `json:"unsubscribeAutomation"`
-			Message               string `json:"message"`
-		} `json:"links"`
-		ID         string      `json:"id"`
-		Automation interface{} `json:"automation"`
-	} `json:"contactList"`
-}
-
-func (s *ContactsService) UpdateListStatusForContact(contact *UpdateListStatusForContactRequest) (*UpdateContactListStatusResponse, *Response, error) {
+	Contacts    []*Contact   `json:"contacts"`
+	ContactList *ContactList `json:"contactList"`
+}
+
+// UpdateListStatusForContact subscribes a contact to, or unsubscribes a contact from, a list.
+func (s *ContactsService) UpdateListStatusForContact(ctx context.Context, contact *UpdateListStatusForContactRequest) (*UpdateContactListStatusResponse, *Response, error) {
 	u := "contactLists"
 	req, err := s.client.NewRequest(http.MethodPost, u, contact)
 	if err != nil {
@@ -186,7 +366,44 @@ func (s *ContactsService) UpdateListStatusForContact(contact *UpdateListStatusFo
 	}
 
 	c := &UpdateContactListStatusResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// SubscribeToList subscribes a contact to a list.
+func (s *ContactsService) SubscribeToList(ctx context.Context, contactID, listID string) (*UpdateContactListStatusResponse, *Response, error) {
+	return s.UpdateListStatusForContact(ctx, &UpdateListStatusForContactRequest{
+		ContactList: &ContactList{List: listID, Contact: contactID, Status: ListStatusActive},
+	})
+}
+
+// UnsubscribeFromList unsubscribes a contact from a list.
+func (s *ContactsService) UnsubscribeFromList(ctx context.Context, contactID, listID string) (*UpdateContactListStatusResponse, *Response, error) {
+	return s.UpdateListStatusForContact(ctx, &UpdateListStatusForContactRequest{
+		ContactList: &ContactList{List: listID, Contact: contactID, Status: ListStatusUnsubscribed},
+	})
+}
+
+// ListMembershipsResponse is the response body from listing the list memberships of a contact.
+type ListMembershipsResponse struct {
+	ContactLists []*ContactList `json:"contactLists"`
+}
+
+// ListMemberships lists the lists a contact belongs to, along with the contact's status on each.
+func (s *ContactsService) ListMemberships(ctx context.Context, contactID string) (*ListMembershipsResponse, *Response, error) {
+	u := "contacts/" + contactID + "/contactLists"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListMembershipsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -218,7 +435,7 @@ type AddTagToContactResponse struct {
 }
 
 // AddTagToContact adds a tag to a contact.
-func (s *ContactsService) AddTagToContact(contact *AddTagToContactRequest) (*AddTagToContactResponse, *Response, error) {
+func (s *ContactsService) AddTagToContact(ctx context.Context, contact *AddTagToContactRequest) (*AddTagToContactResponse, *Response, error) {
 	u := "contactTags"
 	req, err := s.client.NewRequest(http.MethodPost, u, contact)
 	if err != nil {
@@ -226,7 +443,7 @@ func (s *ContactsService) AddTagToContact(contact *AddTagToContactRequest) (*Add
 	}
 
 	c := &AddTagToContactResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -234,3 +451,152 @@ func (s *ContactsService) AddTagToContact(contact *AddTagToContactRequest) (*Add
 
 	return c, resp, nil
 }
+
+// RemoveTagFromContact removes a tag from a contact. contactTagID is the ID of the association
+// returned by AddTagToContact or ListContactTags, not the ID of the tag.
+func (s *ContactsService) RemoveTagFromContact(ctx context.Context, contactTagID string) (*Response, error) {
+	u := "contactTags/" + contactTagID
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListContactTagsResponse is the response body from listing the tags of a contact.
+type ListContactTagsResponse struct {
+	ContactTags []*ContactTag `json:"contactTags"`
+}
+
+// ListContactTags lists the tags applied to a contact.
+func (s *ContactsService) ListContactTags(ctx context.Context, contactID string) (*ListContactTagsResponse, *Response, error) {
+	u := "contacts/" + contactID + "/contactTags"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListContactTagsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RemoveTagByName removes the contact tag with exactly the given name from a contact.
+// If the tag does not exist or is not applied to the contact, nothing is removed and a nil Response is returned.
+func (s *ContactsService) RemoveTagByName(ctx context.Context, contactID, tagName string) (*Response, error) {
+	tag, err := s.client.Tags.find(ctx, tagName, TagTypeContact)
+	if err != nil || tag == nil {
+		return nil, err
+	}
+
+	contactTags, _, err := s.ListContactTags(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ct := range contactTags.ContactTags {
+		if ct.Tag == tag.ID {
+			return s.RemoveTagFromContact(ctx, ct.ID)
+		}
+	}
+
+	return nil, nil
+}
+
+// TagResult is the outcome of applying a single tag in TagByEmail.
+type TagResult struct {
+	// Tag is the name of the tag that was applied.
+	Tag string
+
+	// TagID is the ID the tag name resolved to. It is empty if the tag could not be resolved.
+	TagID string
+
+	// ContactTag is the association between the contact and the tag, if it was applied.
+	ContactTag *ContactTag
+
+	// Err is the error that prevented the tag from being applied, if any.
+	Err error
+}
+
+// TagByEmail applies contact tags by name to the contact with the given email address. The contact and any
+// missing tags are created as needed. Resolved contact and tag IDs are cached on the Client, so repeated calls
+// for the same email or tag name avoid extra lookups.
+//
+// An error is returned only if the contact cannot be resolved. Failures to apply individual tags are reported in
+// the TagResult for that tag, and results are returned in the order the tags were given.
+func (s *ContactsService) TagByEmail(ctx context.Context, email string, tags ...string) ([]*TagResult, error) {
+	contactID, err := s.resolveContactID(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TagResult, len(tags))
+	for i, name := range tags {
+		result := &TagResult{Tag: name}
+		results[i] = result
+
+		result.TagID, result.Err = s.client.Tags.resolveID(ctx, name)
+		if result.Err != nil {
+			continue
+		}
+
+		added, err := s.addTagToContact(ctx, contactID, result.TagID)
+		if IsNotFound(err) {
+			// The contact or tag was deleted since it was cached. Resolve the contact again so that the
+			// remaining tags are not applied to a stale ID, and retry this tag if the contact has changed.
+			s.client.cache.forgetContact(contactID)
+			s.client.cache.forgetTag(result.TagID)
+
+			freshID, resolveErr := s.resolveContactID(ctx, email)
+			if resolveErr != nil {
+				result.Err = err
+				for j := i + 1; j < len(tags); j++ {
+					results[j] = &TagResult{Tag: tags[j], Err: resolveErr}
+				}
+				break
+			}
+			if freshID != contactID {
+				contactID = freshID
+				added, err = s.addTagToContact(ctx, contactID, result.TagID)
+			}
+		}
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		result.ContactTag = added.ContactTag
+	}
+
+	return results, nil
+}
+
+// addTagToContact applies the tag with the given ID to the contact with the given ID.
+func (s *ContactsService) addTagToContact(ctx context.Context, contactID, tagID string) (*AddTagToContactResponse, error) {
+	added, _, err := s.AddTagToContact(ctx, &AddTagToContactRequest{
+		ContactTag: &ContactTag{Contact: contactID, Tag: tagID},
+	})
+	return added, err
+}
+
+// resolveContactID returns the ID of the contact with the given email, creating the contact if needed.
+func (s *ContactsService) resolveContactID(ctx context.Context, email string) (string, error) {
+	if id, ok := s.client.cache.contactID(email); ok {
+		return id, nil
+	}
+
+	synced, _, err := s.Sync(ctx, &SyncContactRequest{&Contact{Email: email}})
+	if err != nil {
+		return "", err
+	}
+	if synced.Contact == nil || synced.Contact.ID == "" {
+		return "", fmt.Errorf("syncing contact %q returned no contact ID", email)
+	}
+
+	s.client.cache.setContactID(email, synced.Contact.ID)
+	return synced.Contact.ID, nil
+}
@@ -20,63 +20,209 @@ func TestContactService_Create(t *testing.T) {
 	}
 
 	mux.HandleFunc("/api/3/contacts", func(w http.ResponseWriter, r *http.Request) {
-		v := new(CreateContactResponse)
+		v := new(CreateContactRequest)
 		_ = json.NewDecoder(r.Body).Decode(v)
 
-		response := &CreateContactResponse{&CreatedContact{
-			Email: "e",
-		}}
-
 		testMethod(t, r, "POST")
-		if !reflect.DeepEqual(v, response) {
-			t.Errorf("Request body = %+v, want %+v", response, input)
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
 		}
 
 		_, _ = fmt.Fprint(w,
 			`
 			{
 				"contact": {
-					"email": "e"
+					"email": "e",
+					"cdate": "2020-06-08T19:49:42-05:00",
+					"links": {
+						"contactTags": "https://your_base_url.api-us1.com/api/3/contacts/1/contactTags"
+					},
+					"id": "1"
 				}
 			}`)
 	})
-	contact, _, err := c.Contacts.Create(input)
+	contact, _, err := c.Contacts.Create(ctx, input)
 	if err != nil {
 		t.Errorf("Contacts.Create returned error: %v", err)
 	}
 
 	want := &CreateContactResponse{
-		&CreatedContact{
+		&Contact{
 			Email: "e",
-			Cdate: "",
-			Udate: "",
-			Orgid: "",
-			Links: struct {
-				BounceLogs         string `json:"bounceLogs"`
-				ContactAutomations string `json:"contactAutomations"`
-				ContactData        string `json:"contactData"`
-				ContactGoals       string `json:"contactGoals"`
-				ContactLists       string `json:"contactLists"`
-				ContactLogs        string `json:"contactLogs"`
-				ContactTags        string `json:"contactTags"`
-				ContactDeals       string `json:"contactDeals"`
-				Deals              string `json:"deals"`
-				FieldValues        string `json:"fieldValues"`
-				GeoIps             string `json:"geoIps"`
-				Notes              string `json:"notes"`
-				Organization       string `json:"organization"`
-				PlusAppend         string `json:"plusAppend"`
-				TrackingLogs       string `json:"trackingLogs"`
-				ScoreValues        string `json:"scoreValues"`
-			}{},
-			ID:           "",
-			Organization: "",
+			Cdate: "2020-06-08T19:49:42-05:00",
+			Links: &ContactLinks{
+				ContactTags: "https://your_base_url.api-us1.com/api/3/contacts/1/contactTags",
+			},
+			ID: "1",
 		}}
 	if !reflect.DeepEqual(contact, want) {
 		t.Errorf("Contacts.Create returned %+v, want %+v", contact, want)
 	}
 }
 
+func TestContactService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contactLists": [{"contact": "1", "list": "2", "status": "1", "id": "3"}],
+				"fieldValues": [{"contact": "1", "field": "4", "value": "v", "id": "5"}],
+				"contact": {
+					"email": "e",
+					"firstName": "f",
+					"edate": null,
+					"organization": null,
+					"accountContacts": [],
+					"id": "1"
+				}
+			}`)
+	})
+	contact, _, err := c.Contacts.Retrieve(ctx, "1")
+	if err != nil {
+		t.Fatalf("Contacts.Retrieve returned error: %v", err)
+	}
+
+	want := &ContactResponse{
+		Contact: &Contact{
+			Email:           "e",
+			FirstName:       "f",
+			AccountContacts: []interface{}{},
+			ID:              "1",
+		},
+		ContactLists: []*ContactList{{Contact: "1", List: "2", Status: ListStatusActive, ID: "3"}},
+		FieldValues:  []*FieldValue{{Contact: "1", Field: "4", Value: "v", ID: "5"}},
+	}
+	if !reflect.DeepEqual(contact, want) {
+		t.Errorf("Contacts.Retrieve returned %+v, want %+v", contact, want)
+	}
+}
+
+func TestContactService_Retrieve_NotFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"message": "No Result found for Subscriber with id 1"}`)
+	})
+
+	contact, _, err := c.Contacts.Retrieve(ctx, "1")
+	if !IsNotFound(err) {
+		t.Errorf("Contacts.Retrieve returned %v, want a not found error", err)
+	}
+	if contact != nil {
+		t.Errorf("Contacts.Retrieve returned %+v, want nil", contact)
+	}
+}
+
+func TestContactService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateContactRequest{
+		&Contact{
+			FirstName: "f",
+		},
+	}
+
+	mux.HandleFunc("/api/3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"contact":{"firstName":"f"}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"contact": {"email": "e", "firstName": "f", "id": "1"}}`)
+	})
+	contact, _, err := c.Contacts.Update(ctx, "1", input)
+	if err != nil {
+		t.Fatalf("Contacts.Update returned error: %v", err)
+	}
+
+	want := &ContactResponse{Contact: &Contact{Email: "e", FirstName: "f", ID: "1"}}
+	if !reflect.DeepEqual(contact, want) {
+		t.Errorf("Contacts.Update returned %+v, want %+v", contact, want)
+	}
+}
+
+func TestContactService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	resp, err := c.Contacts.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Contacts.Delete returned error: %v", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Contacts.Delete returned %+v, want status code %d", resp, http.StatusOK)
+	}
+}
+
+func TestContactService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"limit":                  "50",
+			"email_like":             "@example.com",
+			"listid":                 "2",
+			"tagid":                  "3",
+			"status":                 "1",
+			"filters[created_after]": "2020-01-01",
+			"orders[cdate]":          "DESC",
+		})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contacts": [
+					{"email": "a@example.com", "id": "1"},
+					{"email": "b@example.com", "id": "2"}
+				],
+				"meta": {"total": "2"}
+			}`)
+	})
+
+	opts := &ListContactsOptions{
+		ListOptions:  ListOptions{Limit: 50},
+		EmailLike:    "@example.com",
+		ListID:       "2",
+		TagID:        "3",
+		Status:       "1",
+		CreatedAfter: "2020-01-01",
+		OrderByCdate: "DESC",
+	}
+	contacts, resp, err := c.Contacts.List(ctx, opts)
+	if err != nil {
+		t.Fatalf("Contacts.List returned error: %v", err)
+	}
+
+	want := &ListContactsResponse{
+		Contacts: []*Contact{
+			{Email: "a@example.com", ID: "1"},
+			{Email: "b@example.com", ID: "2"},
+		},
+		Meta: &Meta{Total: "2"},
+	}
+	if !reflect.DeepEqual(contacts, want) {
+		t.Errorf("Contacts.List returned %+v, want %+v", contacts, want)
+	}
+	if resp.Total != 2 || resp.NextOffset != 0 {
+		t.Errorf("Contacts.List Response Total, NextOffset = %d, %d, want 2, 0", resp.Total, resp.NextOffset)
+	}
+}
+
 func TestContactService_UpdateListStatusForContact(t *testing.T) {
 	c, mux, _, teardown := setup()
 	defer teardown()
@@ -85,112 +231,53 @@ func TestContactService_UpdateListStatusForContact(t *testing.T) {
 		&ContactList{
 			List:    "l",
 			Contact: "c",
-			Status:  "1",
+			Status:  ListStatusActive,
 		},
 	}
 
 	mux.HandleFunc("/api/3/contactLists", func(w http.ResponseWriter, r *http.Request) {
-		v := new(UpdateContactListStatusResponse)
-		_ = json.NewDecoder(r.Body).Decode(v)
-
-		response := &UpdateContactListStatusResponse{
-			Contacts: nil,
-			ContactList: struct {
-				Contact               string      `json:"contact"`
-				List                  string      `json:"list"`
-				Form                  interface{} `json:"form"`
-				Seriesid              string      `json:"seriesid"`
-				Sdate                 string      `json:"sdate"`
-				Udate                 interface{} `json:"udate"`
-				Status                string      `json:"status"`
-				Responder             string      `json:"responder"`
-				Sync                  string      `json:"sync"`
-				Unsubreason           string      `json:"unsubreason"`
-				Campaign              interface{} `json:"campaign"`
-				Message               interface{} `json:"message"`
-				FirstName             string      `json:"first_name"`
-				LastName              string      `json:"last_name"`
-				IP4Sub                string      `json:"ip4Sub"`
-				Sourceid              interface{} `json:"sourceid,string"`
-				AutosyncLog           interface{} `json:"autosyncLog"`
-				IP4Last               string      `json:"ip4_last"`
-				IP4Unsub              string      `json:"ip4Unsub"`
-				CreatedTimestamp      string      `json:"created_timestamp"`
-				UpdatedTimestamp      string      `json:"updated_timestamp"`
-				CreatedBy             interface{} `json:"created_by"`
-				UpdatedBy             interface{} `json:"updated_by"`
-				UnsubscribeAutomation interface{} `json:"unsubscribeAutomation"`
-				Links                 struct {
-					Automation            string `json:"automation"`
-					List                  string `json:"list"`
-					Contact               string `json:"contact"`
-					Form                  string `json:"form"`
-					AutosyncLog           string `json:"autosyncLog"`
-					Campaign              string `json:"campaign"`
-					UnsubscribeAutomation string `json:"unsubscribeAutomation"`
-					Message               string `json:"message"`
-				} `json:"links"`
-				ID         string      `json:"id"`
-				Automation interface{} `json:"automation"`
-			}{
-				Contact: "c",
-				List:    "l",
-				Status:  "1",
-			},
-		}
-
 		testMethod(t, r, "POST")
-		if !reflect.DeepEqual(v, response) {
-			t.Errorf("Request body = %+v, want %+v", response, input)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"contactList":{"list":"l","contact":"c","status":"1"}}`+"\n"; got != want {
+			t.Errorf("Request body = %s, want %s", got, want)
 		}
 
-		_, _ = fmt.Fprint(w, `{}`)
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contacts": [{"email": "e", "id": "c"}],
+				"contactList": {
+					"contact": "c",
+					"list": "l",
+					"form": null,
+					"udate": null,
+					"status": 1,
+					"sourceid": 0,
+					"links": {
+						"list": "https://your_base_url.api-us1.com/api/3/contactLists/1/list"
+					},
+					"id": "1"
+				}
+			}`)
 	})
-	contact, _, err := c.Contacts.UpdateListStatusForContact(input)
+	contact, _, err := c.Contacts.UpdateListStatusForContact(ctx, input)
 	if err != nil {
 		t.Errorf("Contacts.UpdateListStatusForContact returned error: %v", err)
 	}
 
 	want := &UpdateContactListStatusResponse{
-		Contacts: nil,
-		ContactList: struct {
-			Contact               string      `json:"contact"`
-			List                  string      `json:"list"`
-			Form                  interface{} `json:"form"`
-			Seriesid              string      `json:"seriesid"`
-			Sdate                 string      `json:"sdate"`
-			Udate                 interface{} `json:"udate"`
-			Status                string      `json:"status"`
-			Responder             string      `json:"responder"`
-			Sync                  string      `json:"sync"`
-			Unsubreason           string      `json:"unsubreason"`
-			Campaign              interface{} `json:"campaign"`
-			Message               interface{} `json:"message"`
-			FirstName             string      `json:"first_name"`
-			LastName              string      `json:"last_name"`
-			IP4Sub                string      `json:"ip4Sub"`
-			Sourceid              interface{} `json:"sourceid,string"`
-			AutosyncLog           interface{} `json:"autosyncLog"`
-			IP4Last               string      `json:"ip4_last"`
-			IP4Unsub              string      `json:"ip4Unsub"`
-			CreatedTimestamp      string      `json:"created_timestamp"`
-			UpdatedTimestamp      string      `json:"updated_timestamp"`
-			CreatedBy             interface{} `json:"created_by"`
-			UpdatedBy             interface{} `json:"updated_by"`
-			UnsubscribeAutomation interface{} `json:"unsubscribeAutomation"`
-			Links                 struct {
-				Automation            string `json:"automation"`
-				List                  string `json:"list"`
-				Contact               string `json:"contact"`
-				Form                  string `json:"form"`
-				AutosyncLog           string `json:"autosyncLog"`
-				Campaign              string `json:"campaign"`
-				UnsubscribeAutomation string `json:"unsubscribeAutomation"`
-				Message               string `json:"message"`
-			} `json:"links"`
-			ID         string      `json:"id"`
-			Automation interface{} `json:"automation"`
-		}{},
+		Contacts: []*Contact{{Email: "e", ID: "c"}},
+		ContactList: &ContactList{
+			Contact:  "c",
+			List:     "l",
+			Status:   ListStatusActive,
+			Sourceid: float64(0),
+			Links: &ContactListLinks{
+				List: "https://your_base_url.api-us1.com/api/3/contactLists/1/list",
+			},
+			ID: "1",
+		},
 	}
 
 	if !reflect.DeepEqual(contact, want) {
@@ -198,6 +285,126 @@ func TestContactService_UpdateListStatusForContact(t *testing.T) {
 	}
 }
 
+func TestContactService_SubscribeToList(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(c *Client) (*UpdateContactListStatusResponse, *Response, error)
+		wantStatus ListStatus
+	}{
+		{
+			name: "subscribe",
+			call: func(c *Client) (*UpdateContactListStatusResponse, *Response, error) {
+				return c.Contacts.SubscribeToList(ctx, "c", "l")
+			},
+			wantStatus: ListStatusActive,
+		},
+		{
+			name: "unsubscribe",
+			call: func(c *Client) (*UpdateContactListStatusResponse, *Response, error) {
+				return c.Contacts.UnsubscribeFromList(ctx, "c", "l")
+			},
+			wantStatus: ListStatusUnsubscribed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/api/3/contactLists", func(w http.ResponseWriter, r *http.Request) {
+				v := new(UpdateListStatusForContactRequest)
+				_ = json.NewDecoder(r.Body).Decode(v)
+
+				testMethod(t, r, "POST")
+				want := &UpdateListStatusForContactRequest{&ContactList{List: "l", Contact: "c", Status: tt.wantStatus}}
+				if !reflect.DeepEqual(v, want) {
+					t.Errorf("Request body = %+v, want %+v", v, want)
+				}
+
+				_, _ = fmt.Fprintf(w, `{"contactList": {"contact": "c", "list": "l", "status": "%s"}}`, string(tt.wantStatus))
+			})
+
+			contactList, _, err := tt.call(c)
+			if err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+			if got := contactList.ContactList.Status; got != tt.wantStatus {
+				t.Errorf("returned status %v, want %v", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestContactService_ListMemberships(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1/contactLists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contactLists": [
+					{"contact": "1", "list": "2", "status": "1", "id": "3"},
+					{"contact": "1", "list": "4", "status": "2", "unsubreason": "r", "id": "5"}
+				]
+			}`)
+	})
+
+	memberships, _, err := c.Contacts.ListMemberships(ctx, "1")
+	if err != nil {
+		t.Fatalf("Contacts.ListMemberships returned error: %v", err)
+	}
+
+	want := &ListMembershipsResponse{
+		ContactLists: []*ContactList{
+			{Contact: "1", List: "2", Status: ListStatusActive, ID: "3"},
+			{Contact: "1", List: "4", Status: ListStatusUnsubscribed, Unsubreason: "r", ID: "5"},
+		},
+	}
+	if !reflect.DeepEqual(memberships, want) {
+		t.Errorf("Contacts.ListMemberships returned %+v, want %+v", memberships, want)
+	}
+}
+
+func TestListStatus_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ListStatus
+	}{
+		{`"1"`, ListStatusActive},
+		{`2`, ListStatusUnsubscribed},
+		{`"0"`, ListStatusUnconfirmed},
+		{`""`, ""},
+	}
+	for _, tt := range tests {
+		got := ListStatusBounced
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	got := ListStatusActive
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || got != ListStatusActive {
+		t.Errorf("Unmarshal(null) = %v, %v, want %v unchanged", got, err, ListStatusActive)
+	}
+	if err := json.Unmarshal([]byte(`"active"`), &got); err == nil {
+		t.Errorf("Unmarshal(\"active\") returned nil error, want an error")
+	}
+}
+
+func TestListStatus_String(t *testing.T) {
+	if got, want := ListStatusActive.String(), "active"; got != want {
+		t.Errorf("ListStatusActive.String() = %q, want %q", got, want)
+	}
+	if got, want := ListStatus("9").String(), "9"; got != want {
+		t.Errorf("ListStatus(9).String() = %q, want %q", got, want)
+	}
+}
+
 func TestContactService_AddTagToContact(t *testing.T) {
 	c, mux, _, teardown := setup()
 	defer teardown()
@@ -243,7 +450,7 @@ func TestContactService_AddTagToContact(t *testing.T) {
 				}
 			}`)
 	})
-	contact, _, err := c.Contacts.AddTagToContact(input)
+	contact, _, err := c.Contacts.AddTagToContact(ctx, input)
 	if err != nil {
 		t.Errorf("Contacts.AddTagToContact returned error: %v", err)
 	}
@@ -283,10 +490,13 @@ func TestContactService_AddTagToContact_contactNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 		_, _ = fmt.Fprint(w, `{"message": "Contact not found"}`)
 	})
-	contactTag, resp, err := c.Contacts.AddTagToContact(input)
+	contactTag, resp, err := c.Contacts.AddTagToContact(ctx, input)
 	if err == nil {
 		t.Error("Contacts.AddTagToContact returned nil err, want not nil")
 	}
+	if !IsNotFound(err) {
+		t.Errorf("Contacts.AddTagToContact returned %v, want a not found error", err)
+	}
 	if contactTag != nil {
 		t.Errorf("Contacts.AddTagToContact returned %+v, want nil", contactTag)
 	}
@@ -306,3 +516,397 @@ func TestContactService_AddTagToContact_contactNotFound(t *testing.T) {
 		t.Errorf("Contacts.AddTagToContact resp.Body returned %+v, want %+v", bodyString, want)
 	}
 }
+
+func TestContactService_Sync(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		wantCreated bool
+	}{
+		{"created", http.StatusCreated, true},
+		{"updated", http.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mux, _, teardown := setup()
+			defer teardown()
+
+			input := &SyncContactRequest{
+				&Contact{
+					Email:     "e",
+					FirstName: "f",
+					FieldValues: []*FieldValue{
+						{Field: "1", Value: "v"},
+					},
+				},
+			}
+
+			mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+
+				body, _ := ioutil.ReadAll(r.Body)
+				want := `{"contact":{"email":"e","firstName":"f","fieldValues":[{"field":"1","value":"v"}]}}` + "\n"
+				if got := string(body); got != want {
+					t.Errorf("Request body = %s, want %s", got, want)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w,
+					`
+					{
+						"fieldValues": [{"contact": "2", "field": "1", "value": "v", "id": "3"}],
+						"contact": {"email": "e", "firstName": "f", "id": "2"}
+					}`)
+			})
+			contact, _, err := c.Contacts.Sync(ctx, input)
+			if err != nil {
+				t.Fatalf("Contacts.Sync returned error: %v", err)
+			}
+
+			want := &SyncContactResponse{
+				Contact:     &Contact{Email: "e", FirstName: "f", ID: "2"},
+				FieldValues: []*FieldValue{{Contact: "2", Field: "1", Value: "v", ID: "3"}},
+				Created:     tt.wantCreated,
+			}
+			if !reflect.DeepEqual(contact, want) {
+				t.Errorf("Contacts.Sync returned %+v, want %+v", contact, want)
+			}
+		})
+	}
+}
+
+func TestContactService_RemoveTagFromContact(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contactTags/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Contacts.RemoveTagFromContact(ctx, "3")
+	if err != nil {
+		t.Errorf("Contacts.RemoveTagFromContact returned error: %v", err)
+	}
+}
+
+func TestContactService_ListContactTags(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contactTags": [
+					{"contact": "1", "tag": "2", "cdate": "2020-06-08T19:49:42-05:00", "id": "3"}
+				]
+			}`)
+	})
+
+	contactTags, _, err := c.Contacts.ListContactTags(ctx, "1")
+	if err != nil {
+		t.Fatalf("Contacts.ListContactTags returned error: %v", err)
+	}
+
+	want := &ListContactTagsResponse{
+		ContactTags: []*ContactTag{{CDate: "2020-06-08T19:49:42-05:00", Contact: "1", ID: "3", Tag: "2"}},
+	}
+	if !reflect.DeepEqual(contactTags, want) {
+		t.Errorf("Contacts.ListContactTags returned %+v, want %+v", contactTags, want)
+	}
+}
+
+func TestContactService_RemoveTagByName(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"search": "vip", "limit": "100"})
+		_, _ = fmt.Fprint(w, `{"tags": [{"tag": "vip-gold", "tagType": "contact", "id": "1"}, {"tag": "vip", "tagType": "contact", "id": "2"}]}`)
+	})
+	mux.HandleFunc("/api/3/contacts/7/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"contactTags": [{"contact": "7", "tag": "1", "id": "8"}, {"contact": "7", "tag": "2", "id": "9"}]}`)
+	})
+	deleted := false
+	mux.HandleFunc("/api/3/contactTags/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	resp, err := c.Contacts.RemoveTagByName(ctx, "7", "vip")
+	if err != nil {
+		t.Fatalf("Contacts.RemoveTagByName returned error: %v", err)
+	}
+	if resp == nil {
+		t.Errorf("Contacts.RemoveTagByName returned nil response, want not nil")
+	}
+	if !deleted {
+		t.Errorf("Contacts.RemoveTagByName did not delete contact tag 9")
+	}
+}
+
+func TestContactService_RemoveTagByName_notTagged(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"tags": [{"tag": "vip", "tagType": "contact", "id": "2"}]}`)
+	})
+	mux.HandleFunc("/api/3/contacts/7/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"contactTags": [{"contact": "7", "tag": "1", "id": "8"}]}`)
+	})
+	mux.HandleFunc("/api/3/contactTags/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Contacts.RemoveTagByName deleted %s, want no deletion", r.URL.Path)
+	})
+
+	resp, err := c.Contacts.RemoveTagByName(ctx, "7", "vip")
+	if err != nil {
+		t.Fatalf("Contacts.RemoveTagByName returned error: %v", err)
+	}
+	if resp != nil {
+		t.Errorf("Contacts.RemoveTagByName returned %+v, want nil", resp)
+	}
+}
+
+func TestContactService_TagByEmail(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	syncs, searches := 0, 0
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		syncs++
+		v := new(SyncContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+		if v.Contact.Email != "e@example.com" {
+			t.Errorf("Request body 'email' = %v, want e@example.com", v.Contact.Email)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"contact": {"email": "e@example.com", "id": "1"}}`)
+	})
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			searches++
+			if r.URL.Query().Get("search") == "existing" {
+				_, _ = fmt.Fprint(w, `{"tags": [{"tag": "existing", "tagType": "contact", "id": "10"}]}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"tags": []}`)
+		case http.MethodPost:
+			_, _ = fmt.Fprint(w, `{"tag": {"tag": "new", "tagType": "contact", "id": "11"}}`)
+		}
+	})
+	mux.HandleFunc("/api/3/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddTagToContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+		_, _ = fmt.Fprintf(w, `{"contactTag": {"contact": "%s", "tag": "%s", "id": "c%s"}}`,
+			v.ContactTag.Contact, v.ContactTag.Tag, v.ContactTag.Tag)
+	})
+
+	for i := 0; i < 2; i++ {
+		results, err := c.Contacts.TagByEmail(ctx, "e@example.com", "existing", "new")
+		if err != nil {
+			t.Fatalf("Contacts.TagByEmail returned error: %v", err)
+		}
+
+		want := []*TagResult{
+			{Tag: "existing", TagID: "10", ContactTag: &ContactTag{Contact: "1", Tag: "10", ID: "c10"}},
+			{Tag: "new", TagID: "11", ContactTag: &ContactTag{Contact: "1", Tag: "11", ID: "c11"}},
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Errorf("Contacts.TagByEmail returned %+v, want %+v", results, want)
+		}
+	}
+
+	// The second call is served from the cache.
+	if syncs != 1 {
+		t.Errorf("Contacts.TagByEmail synced the contact %d times, want 1", syncs)
+	}
+	if searches != 2 {
+		t.Errorf("Contacts.TagByEmail searched tags %d times, want 2", searches)
+	}
+}
+
+func TestContactService_TagByEmail_tagError(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	c.cache.setContactID("e@example.com", "1")
+	c.cache.setTagID("gone", "10")
+	c.cache.setTagID("ok", "11")
+
+	syncs := 0
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		syncs++
+		_, _ = fmt.Fprint(w, `{"contact": {"email": "e@example.com", "id": "1"}}`)
+	})
+	mux.HandleFunc("/api/3/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddTagToContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+		if v.ContactTag.Tag == "10" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"message": "Tag not found"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"contactTag": {"contact": "1", "tag": "11", "id": "5"}}`)
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "E@example.com", "gone", "ok")
+	if err != nil {
+		t.Fatalf("Contacts.TagByEmail returned error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Contacts.TagByEmail returned %d results, want 2", len(results))
+	}
+	if !IsNotFound(results[0].Err) {
+		t.Errorf("Contacts.TagByEmail result for 'gone' has error %v, want a not found error", results[0].Err)
+	}
+	if results[1].Err != nil || results[1].ContactTag == nil {
+		t.Errorf("Contacts.TagByEmail result for 'ok' = %+v, want applied tag", results[1])
+	}
+
+	// Not found responses evict the stale tag ID and resolve the contact again.
+	if _, ok := c.cache.tagID("gone"); ok {
+		t.Errorf("tag 'gone' is still cached")
+	}
+	if syncs != 1 {
+		t.Errorf("Contacts.TagByEmail synced the contact %d times, want 1", syncs)
+	}
+}
+
+func TestContactService_TagByEmail_contactDeleted(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	c.cache.setContactID("e@example.com", "1")
+	c.cache.setTagID("a", "10")
+	c.cache.setTagID("b", "11")
+
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"contact": {"email": "e@example.com", "id": "2"}}`)
+	})
+	mux.HandleFunc("/api/3/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddTagToContactRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+		if v.ContactTag.Contact == "1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"message": "Contact not found"}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"contactTag": {"contact": "%s", "tag": "%s", "id": "c%s"}}`,
+			v.ContactTag.Contact, v.ContactTag.Tag, v.ContactTag.Tag)
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "e@example.com", "a", "b")
+	if err != nil {
+		t.Fatalf("Contacts.TagByEmail returned error: %v", err)
+	}
+
+	want := []*TagResult{
+		{Tag: "a", TagID: "10", ContactTag: &ContactTag{Contact: "2", Tag: "10", ID: "c10"}},
+		{Tag: "b", TagID: "11", ContactTag: &ContactTag{Contact: "2", Tag: "11", ID: "c11"}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Contacts.TagByEmail returned %+v, want %+v", results, want)
+	}
+}
+
+func TestContactService_TagByEmail_contactLost(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	c.cache.setContactID("e@example.com", "1")
+	c.cache.setTagID("a", "10")
+	c.cache.setTagID("b", "11")
+
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = fmt.Fprint(w, `{"errors": [{"title": "Contact Email Address is not valid.", "code": "email_invalid"}]}`)
+	})
+	adds := 0
+	mux.HandleFunc("/api/3/contactTags", func(w http.ResponseWriter, r *http.Request) {
+		adds++
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"message": "Contact not found"}`)
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "e@example.com", "a", "b")
+	if err != nil {
+		t.Fatalf("Contacts.TagByEmail returned error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Contacts.TagByEmail returned %d results, want 2", len(results))
+	}
+	if !IsNotFound(results[0].Err) {
+		t.Errorf("Contacts.TagByEmail result for 'a' has error %v, want a not found error", results[0].Err)
+	}
+	if !IsValidation(results[1].Err) {
+		t.Errorf("Contacts.TagByEmail result for 'b' has error %v, want a validation error", results[1].Err)
+	}
+	if adds != 1 {
+		t.Errorf("Contacts.TagByEmail tried to add %d tags, want 1", adds)
+	}
+}
+
+func TestContactService_TagByEmail_noContactID(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "e@example.com", "t")
+	if err == nil {
+		t.Errorf("Contacts.TagByEmail returned no error for a sync without a contact")
+	}
+	if results != nil {
+		t.Errorf("Contacts.TagByEmail returned %+v, want nil", results)
+	}
+}
+
+func TestContactService_TagByEmail_tagNotResolved(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	c.cache.setContactID("e@example.com", "1")
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprint(w, `{"tags": []}`)
+		case http.MethodPost:
+			_, _ = fmt.Fprint(w, `{}`)
+		}
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "e@example.com", "t")
+	if err != nil {
+		t.Fatalf("Contacts.TagByEmail returned error: %v", err)
+	}
+	if len(results) != 1 || results[0].Err == nil {
+		t.Errorf("Contacts.TagByEmail returned %+v, want an error for the unresolved tag", results)
+	}
+}
+
+func TestContactService_TagByEmail_contactError(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contact/sync", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = fmt.Fprint(w, `{"errors": [{"title": "Contact Email Address is not valid.", "code": "email_invalid"}]}`)
+	})
+
+	results, err := c.Contacts.TagByEmail(ctx, "not-an-email", "t")
+	if !IsValidation(err) {
+		t.Errorf("Contacts.TagByEmail returned %v, want a validation error", err)
+	}
+	if results != nil {
+		t.Errorf("Contacts.TagByEmail returned %+v, want nil", results)
+	}
+}
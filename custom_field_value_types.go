@@ -0,0 +1,235 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Typed custom field values encode to, and decode from, the wire formats Active Campaign uses for
+// custom fields. Assign one to FieldValue.Value when writing a value, and use FieldValue.DecodeValue
+// to read one back:
+//
+//	fv := &ac.FieldValue{Contact: "1", Field: "2", Value: ac.DateValue(birthday)}
+//
+//	var sizes ac.MultiValue
+//	err := fv.DecodeValue(&sizes)
+
+const (
+	dateValueLayout    = "2006-01-02"
+	multiValueSep      = "||"
+	datetimeValueSpace = "2006-01-02 15:04:05"
+	datetimeValueLocal = "2006-01-02T15:04:05"
+)
+
+// DateValue is the value of a date field, encoded as YYYY-MM-DD.
+type DateValue time.Time
+
+// Time returns d as a time.Time.
+func (d DateValue) Time() time.Time {
+	return time.Time(d)
+}
+
+func (d DateValue) String() string {
+	return time.Time(d).Format(dateValueLayout)
+}
+
+// MarshalJSON encodes d as a YYYY-MM-DD string.
+func (d DateValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a YYYY-MM-DD string. An empty string decodes to the zero time.
+func (d *DateValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = DateValue{}
+		return nil
+	}
+
+	t, err := time.Parse(dateValueLayout, s)
+	if err != nil {
+		return err
+	}
+	*d = DateValue(t)
+	return nil
+}
+
+// DatetimeValue is the value of a datetime field, encoded as ISO 8601.
+type DatetimeValue time.Time
+
+// Time returns d as a time.Time.
+func (d DatetimeValue) Time() time.Time {
+	return time.Time(d)
+}
+
+func (d DatetimeValue) String() string {
+	return time.Time(d).Format(time.RFC3339)
+}
+
+// MarshalJSON encodes d as an ISO 8601 string.
+func (d DatetimeValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes an ISO 8601 string. Datetimes without a zone, which Active Campaign returns for
+// some accounts, are parsed as UTC. An empty string decodes to the zero time.
+func (d *DatetimeValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = DatetimeValue{}
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339, datetimeValueLocal, datetimeValueSpace} {
+		if t, err := time.Parse(layout, s); err == nil {
+			*d = DatetimeValue(t)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse %q as a datetime field value", s)
+}
+
+// MultiValue is the value of a multiselect or checkbox field, encoded as ||option1||option2||.
+type MultiValue []string
+
+func (m MultiValue) String() string {
+	if len(m) == 0 {
+		return ""
+	}
+	return multiValueSep + strings.Join(m, multiValueSep) + multiValueSep
+}
+
+// MarshalJSON encodes m as a ||option1||option2|| string.
+func (m MultiValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON decodes a ||option1||option2|| string. A single option without delimiters is also accepted,
+// as is an array of options, which is how deal and account custom fields return them.
+func (m *MultiValue) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var options []string
+		if err := json.Unmarshal(data, &options); err != nil {
+			return err
+		}
+		*m = options
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	var options MultiValue
+	for _, option := range strings.Split(s, multiValueSep) {
+		if option != "" {
+			options = append(options, option)
+		}
+	}
+	*m = options
+	return nil
+}
+
+// CurrencyValue is the value of a currency field, in cents.
+//
+// Deal and account currency values are sent as a number of cents, which is how a CurrencyValue encodes.
+// Contact currency values are decimal strings such as "15.99": to write one, set FieldValue.Value to
+// the String of the CurrencyValue. Both formats can be decoded.
+type CurrencyValue int64
+
+// String returns c as a decimal amount, such as "15.99".
+func (c CurrencyValue) String() string {
+	sign, cents := "", int64(c)
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON encodes c as a number of cents.
+func (c CurrencyValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(c))
+}
+
+// UnmarshalJSON decodes a number of cents, sent as either a number or a string, or a decimal amount
+// string such as "15.99".
+func (c *CurrencyValue) UnmarshalJSON(data []byte) error {
+	if s := string(data); s == `""` || s == "null" {
+		*c = 0
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+
+	if strings.Contains(n.String(), ".") {
+		cents, err := parseDecimalCents(n.String())
+		if err != nil {
+			return err
+		}
+		*c = CurrencyValue(cents)
+		return nil
+	}
+
+	cents, err := n.Int64()
+	if err != nil {
+		return err
+	}
+	*c = CurrencyValue(cents)
+	return nil
+}
+
+// parseDecimalCents parses a decimal amount with at most two fractional digits, such as "15.99" or "-0.5",
+// into a number of cents.
+func parseDecimalCents(s string) (int64, error) {
+	units, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		units, fraction = s[:i], s[i+1:]
+	}
+	if len(fraction) > 2 {
+		return 0, fmt.Errorf("cannot parse %q as a currency value: more than two decimal places", s)
+	}
+
+	negative := strings.HasPrefix(units, "-")
+	units = strings.TrimPrefix(units, "-")
+	if units == "" {
+		units = "0"
+	}
+
+	fraction = (fraction + "00")[:2]
+	cents, err := strconv.ParseInt(units+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as a currency value: %w", s, err)
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// DecodeValue decodes the value of fv into v, which should be a pointer to a typed value such as
+// *DateValue, *DatetimeValue, *MultiValue or *CurrencyValue, or any other type the value can be decoded to.
+func (fv *FieldValue) DecodeValue(v interface{}) error {
+	return decodeFieldValue(fv.Value, v)
+}
+
+// decodeFieldValue decodes a custom field value that was itself decoded into an interface{} into v.
+func decodeFieldValue(value, v interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
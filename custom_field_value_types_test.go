@@ -0,0 +1,186 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDateValue(t *testing.T) {
+	d := DateValue(time.Date(2020, 6, 24, 15, 30, 54, 0, time.UTC))
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if got, want := string(data), `"2020-06-24"`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	var got DateValue
+	if err := json.Unmarshal([]byte(`"2020-06-24"`), &got); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if want := time.Date(2020, 6, 24, 0, 0, 0, 0, time.UTC); !got.Time().Equal(want) {
+		t.Errorf("Unmarshal = %v, want %v", got.Time(), want)
+	}
+
+	if err := json.Unmarshal([]byte(`"24/06/2020"`), &got); err == nil {
+		t.Errorf("Unmarshal of an invalid date returned nil error")
+	}
+}
+
+func TestDatetimeValue(t *testing.T) {
+	loc := time.FixedZone("CDT", -5*60*60)
+	d := DatetimeValue(time.Date(2020, 6, 24, 15, 30, 54, 0, loc))
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if got, want := string(data), `"2020-06-24T15:30:54-05:00"`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`"2020-06-24T15:30:54-05:00"`, time.Date(2020, 6, 24, 15, 30, 54, 0, loc)},
+		{`"2020-06-24T15:30:54"`, time.Date(2020, 6, 24, 15, 30, 54, 0, time.UTC)},
+		{`"2020-06-24 15:30:54"`, time.Date(2020, 6, 24, 15, 30, 54, 0, time.UTC)},
+		{`""`, time.Time{}},
+	}
+	for _, tt := range tests {
+		var got DatetimeValue
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", tt.in, err)
+		}
+		if !got.Time().Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got.Time(), tt.want)
+		}
+	}
+
+	var got DatetimeValue
+	if err := json.Unmarshal([]byte(`"tomorrow"`), &got); err == nil {
+		t.Errorf("Unmarshal of an invalid datetime returned nil error")
+	}
+}
+
+func TestMultiValue(t *testing.T) {
+	data, err := json.Marshal(MultiValue{"small", "large"})
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if got, want := string(data), `"||small||large||"`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	data, _ = json.Marshal(MultiValue{})
+	if got, want := string(data), `""`; got != want {
+		t.Errorf("Marshal of an empty value = %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		in   string
+		want MultiValue
+	}{
+		{`"||small||large||"`, MultiValue{"small", "large"}},
+		{`"small"`, MultiValue{"small"}},
+		{`""`, nil},
+	}
+	for _, tt := range tests {
+		var got MultiValue
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", tt.in, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Unmarshal(%s) = %#v, want %#v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCurrencyValue(t *testing.T) {
+	data, err := json.Marshal(CurrencyValue(1599))
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if got, want := string(data), `1599`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	for _, in := range []string{`1599`, `"1599"`} {
+		var got CurrencyValue
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if got != 1599 {
+			t.Errorf("Unmarshal(%s) = %d, want 1599", in, got)
+		}
+	}
+
+	// Contact currency values are decimal strings.
+	for in, want := range map[string]CurrencyValue{`"15.99"`: 1599, `"15.5"`: 1550, `"-0.05"`: -5, `15.99`: 1599, `""`: 0} {
+		got := CurrencyValue(1)
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if got != want {
+			t.Errorf("Unmarshal(%s) = %d, want %d", in, got, want)
+		}
+	}
+	for _, in := range []string{`"15.999"`, `"1.2.3"`, `"abc"`} {
+		var got CurrencyValue
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) returned nil error, want an error", in)
+		}
+	}
+
+	// A value round trips through its decimal string.
+	var roundTrip CurrencyValue
+	fv := &FieldValue{Value: CurrencyValue(-1234).String()}
+	if err := fv.DecodeValue(&roundTrip); err != nil || roundTrip != -1234 {
+		t.Errorf("DecodeValue(%q) = %d, %v, want -1234", fv.Value, roundTrip, err)
+	}
+
+	if got, want := CurrencyValue(1599).String(), "15.99"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got, want := CurrencyValue(-5).String(), "-0.05"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestFieldValue_DecodeValue(t *testing.T) {
+	fv := new(FieldValue)
+	if err := json.Unmarshal([]byte(`{"contact": "1", "field": "2", "value": "||a||b||"}`), fv); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	var got MultiValue
+	if err := fv.DecodeValue(&got); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if want := (MultiValue{"a", "b"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeValue = %v, want %v", got, want)
+	}
+}
+
+func TestFieldValue_typedValueRequestBody(t *testing.T) {
+	input := &CreateCustomFieldValueRequest{
+		&FieldValue{
+			Contact: "1",
+			Field:   "2",
+			Value:   DateValue(time.Date(2020, 6, 24, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if got, want := string(data), `{"fieldValue":{"contact":"1","field":"2","value":"2020-06-24"}}`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+}
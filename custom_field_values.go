@@ -1,6 +1,9 @@
 package active_campaign
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // Custom Field Values are part of the Contacts Service.
 
@@ -10,8 +13,9 @@ type CreateCustomFieldValueRequest struct {
 }
 
 // FieldValue stores a custom field value and the contact information it is attached to.
+// Value may be set to a string, or to a typed value such as DateValue or MultiValue to get the encoding right.
 type FieldValue struct {
-	Contact string      `json:"contact"`
+	Contact string      `json:"contact,omitempty"`
 	Field   interface{} `json:"field"`
 	Value   interface{} `json:"value"`
 	Cdate   string      `json:"cdate,omitempty"`
@@ -26,68 +30,12 @@ type FieldValue struct {
 
 // CreateCustomFieldValueResponse is the response body from updating a custom field value on a contact.
 type CreateCustomFieldValueResponse struct {
-	Contacts []struct {
-		Cdate               string        `json:"cdate"`
-		Email               string        `json:"email"`
-		Phone               string        `json:"phone"`
-		FirstName           string        `json:"firstName"`
-		LastName            string        `json:"lastName"`
-		Orgid               string        `json:"orgid"`
-		Orgname             string        `json:"orgname"`
-		SegmentioID         string        `json:"segmentio_id"`
-		BouncedHard         string        `json:"bounced_hard"`
-		BouncedSoft         string        `json:"bounced_soft"`
-		BouncedDate         string        `json:"bounced_date"`
-		IP                  string        `json:"ip"`
-		Ua                  string        `json:"ua"`
-		Hash                string        `json:"hash"`
-		SocialdataLastcheck string        `json:"socialdata_lastcheck"`
-		EmailLocal          string        `json:"email_local"`
-		EmailDomain         string        `json:"email_domain"`
-		Sentcnt             string        `json:"sentcnt"`
-		RatingTstamp        string        `json:"rating_tstamp"`
-		Gravatar            string        `json:"gravatar"`
-		Deleted             string        `json:"deleted"`
-		Anonymized          string        `json:"anonymized"`
-		Adate               string        `json:"adate"`
-		Udate               string        `json:"udate"`
-		Edate               interface{}   `json:"edate"`
-		DeletedAt           string        `json:"deleted_at"`
-		CreatedUtcTimestamp string        `json:"created_utc_timestamp"`
-		UpdatedUtcTimestamp string        `json:"updated_utc_timestamp"`
-		CreatedTimestamp    string        `json:"created_timestamp"`
-		UpdatedTimestamp    string        `json:"updated_timestamp"`
-		CreatedBy           string        `json:"created_by"`
-		UpdatedBy           string        `json:"updated_by"`
-		EmailEmpty          bool          `json:"email_empty"`
-		AccountContacts     []interface{} `json:"accountContacts"`
-		Links               struct {
-			BounceLogs            string `json:"bounceLogs"`
-			ContactAutomations    string `json:"contactAutomations"`
-			ContactData           string `json:"contactData"`
-			ContactGoals          string `json:"contactGoals"`
-			ContactLists          string `json:"contactLists"`
-			ContactLogs           string `json:"contactLogs"`
-			ContactTags           string `json:"contactTags"`
-			ContactDeals          string `json:"contactDeals"`
-			Deals                 string `json:"deals"`
-			FieldValues           string `json:"fieldValues"`
-			GeoIps                string `json:"geoIps"`
-			Notes                 string `json:"notes"`
-			Organization          string `json:"organization"`
-			PlusAppend            string `json:"plusAppend"`
-			TrackingLogs          string `json:"trackingLogs"`
-			ScoreValues           string `json:"scoreValues"`
-			AutomationEntryCounts string `json:"automationEntryCounts"`
-		} `json:"links"`
-		ID           string      `json:"id"`
-		Organization interface{} `json:"organization"`
-	} `json:"contacts"`
+	Contacts   []*Contact  `json:"contacts"`
 	FieldValue *FieldValue `json:"fieldValue"`
 }
 
 // CreateCustomFieldValue adds a custom field to a contact.
-func (s *ContactsService) CreateCustomFieldValue(fieldValue *CreateCustomFieldValueRequest) (*CreateCustomFieldValueResponse, *Response, error) {
+func (s *ContactsService) CreateCustomFieldValue(ctx context.Context, fieldValue *CreateCustomFieldValueRequest) (*CreateCustomFieldValueResponse, *Response, error) {
 	u := "fieldValues"
 	req, err := s.client.NewRequest(http.MethodPost, u, fieldValue)
 	if err != nil {
@@ -95,7 +43,122 @@ func (s *ContactsService) CreateCustomFieldValue(fieldValue *CreateCustomFieldVa
 	}
 
 	c := &CreateCustomFieldValueResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// FieldValueResponse is the response body from retrieving or updating a custom field value.
+type FieldValueResponse struct {
+	Contacts   []*Contact  `json:"contacts,omitempty"`
+	FieldValue *FieldValue `json:"fieldValue"`
+}
+
+// RetrieveFieldValue retrieves a custom field value.
+func (s *ContactsService) RetrieveFieldValue(ctx context.Context, id string) (*FieldValueResponse, *Response, error) {
+	u := "fieldValues/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateCustomFieldValueRequest is the request body used for updating an existing custom field value.
+type UpdateCustomFieldValueRequest struct {
+	FieldValue *FieldValue `json:"fieldValue"`
+}
+
+// UpdateFieldValue updates an existing custom field value.
+func (s *ContactsService) UpdateFieldValue(ctx context.Context, id string, fieldValue *UpdateCustomFieldValueRequest) (*FieldValueResponse, *Response, error) {
+	u := "fieldValues/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, fieldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DeleteFieldValue deletes a custom field value.
+func (s *ContactsService) DeleteFieldValue(ctx context.Context, id string) (*Response, error) {
+	u := "fieldValues/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListFieldValuesOptions specifies the optional parameters to ContactsService.ListFieldValues.
+type ListFieldValuesOptions struct {
+	ListOptions
+
+	// FieldID filters values by the field they belong to.
+	FieldID string `url:"filters[fieldid],omitempty"`
+	// Value filters values by their exact value.
+	Value string `url:"filters[val],omitempty"`
+}
+
+// ListFieldValuesResponse is the response body from listing custom field values.
+type ListFieldValuesResponse struct {
+	FieldValues []*FieldValue `json:"fieldValues"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// ListFieldValues lists custom field values across all contacts, one page at a time.
+// Use ListAllPages to walk every page.
+func (s *ContactsService) ListFieldValues(ctx context.Context, opts *ListFieldValuesOptions) (*ListFieldValuesResponse, *Response, error) {
+	u, err := addOptions("fieldValues", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListFieldValuesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// ListContactFieldValues lists the custom field values of a contact.
+func (s *ContactsService) ListContactFieldValues(ctx context.Context, contactID string) (*ListFieldValuesResponse, *Response, error) {
+	u := "contacts/" + contactID + "/fieldValues"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListFieldValuesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
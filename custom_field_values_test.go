@@ -51,7 +51,7 @@ func TestContactsService_CreateCustomFieldValue(t *testing.T) {
 				}
 			}`)
 	})
-	fieldValue, _, err := c.Contacts.CreateCustomFieldValue(input)
+	fieldValue, _, err := c.Contacts.CreateCustomFieldValue(ctx, input)
 	if err != nil {
 		t.Errorf("Contacts.CreateCustomFieldValue returned error: %v", err)
 	}
@@ -73,3 +73,144 @@ func TestContactsService_CreateCustomFieldValue(t *testing.T) {
 		t.Errorf("Contacts.CreateCustomFieldValue returned %+v, want %+v", fieldValue, want)
 	}
 }
+
+func TestContactsService_RetrieveFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fieldValues/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"fieldValue": {"contact": "1", "field": "2", "value": "v", "owner": "1", "id": "10"}}`)
+	})
+
+	fieldValue, _, err := c.Contacts.RetrieveFieldValue(ctx, "10")
+	if err != nil {
+		t.Fatalf("Contacts.RetrieveFieldValue returned error: %v", err)
+	}
+
+	want := &FieldValueResponse{
+		FieldValue: &FieldValue{Contact: "1", Field: "2", Value: "v", Owner: "1", ID: "10"},
+	}
+	if !reflect.DeepEqual(fieldValue, want) {
+		t.Errorf("Contacts.RetrieveFieldValue returned %+v, want %+v", fieldValue, want)
+	}
+}
+
+func TestContactsService_UpdateFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateCustomFieldValueRequest{
+		&FieldValue{Contact: "1", Field: "2", Value: "new"},
+	}
+
+	mux.HandleFunc("/api/3/fieldValues/10", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateCustomFieldValueRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"contacts": [{"email": "e", "id": "1"}],
+				"fieldValue": {"contact": "1", "field": "2", "value": "new", "id": "10"}
+			}`)
+	})
+
+	fieldValue, _, err := c.Contacts.UpdateFieldValue(ctx, "10", input)
+	if err != nil {
+		t.Fatalf("Contacts.UpdateFieldValue returned error: %v", err)
+	}
+
+	want := &FieldValueResponse{
+		Contacts:   []*Contact{{Email: "e", ID: "1"}},
+		FieldValue: &FieldValue{Contact: "1", Field: "2", Value: "new", ID: "10"},
+	}
+	if !reflect.DeepEqual(fieldValue, want) {
+		t.Errorf("Contacts.UpdateFieldValue returned %+v, want %+v", fieldValue, want)
+	}
+}
+
+func TestContactsService_DeleteFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fieldValues/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Contacts.DeleteFieldValue(ctx, "10")
+	if err != nil {
+		t.Errorf("Contacts.DeleteFieldValue returned error: %v", err)
+	}
+}
+
+func TestContactsService_ListFieldValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[fieldid]": "2", "filters[val]": "v", "offset": "20"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"fieldValues": [{"contact": "1", "field": "2", "value": "v", "id": "10"}],
+				"meta": {"total": "21"}
+			}`)
+	})
+
+	opts := &ListFieldValuesOptions{ListOptions: ListOptions{Offset: 20}, FieldID: "2", Value: "v"}
+	fieldValues, resp, err := c.Contacts.ListFieldValues(ctx, opts)
+	if err != nil {
+		t.Fatalf("Contacts.ListFieldValues returned error: %v", err)
+	}
+
+	want := &ListFieldValuesResponse{
+		FieldValues: []*FieldValue{{Contact: "1", Field: "2", Value: "v", ID: "10"}},
+		Meta:        &Meta{Total: "21"},
+	}
+	if !reflect.DeepEqual(fieldValues, want) {
+		t.Errorf("Contacts.ListFieldValues returned %+v, want %+v", fieldValues, want)
+	}
+	if resp.Total != 21 || resp.NextOffset != 0 {
+		t.Errorf("Contacts.ListFieldValues Response Total, NextOffset = %d, %d, want 21, 0", resp.Total, resp.NextOffset)
+	}
+}
+
+func TestContactsService_ListContactFieldValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/contacts/1/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"fieldValues": [
+					{"contact": "1", "field": "2", "value": "a", "id": "10"},
+					{"contact": "1", "field": "3", "value": "b", "id": "11"}
+				]
+			}`)
+	})
+
+	fieldValues, _, err := c.Contacts.ListContactFieldValues(ctx, "1")
+	if err != nil {
+		t.Fatalf("Contacts.ListContactFieldValues returned error: %v", err)
+	}
+
+	want := &ListFieldValuesResponse{
+		FieldValues: []*FieldValue{
+			{Contact: "1", Field: "2", Value: "a", ID: "10"},
+			{Contact: "1", Field: "3", Value: "b", ID: "11"},
+		},
+	}
+	if !reflect.DeepEqual(fieldValues, want) {
+		t.Errorf("Contacts.ListContactFieldValues returned %+v, want %+v", fieldValues, want)
+	}
+}
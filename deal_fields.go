@@ -0,0 +1,323 @@
+package active_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// DealFieldsService handles communication with deal custom field related
+// methods of the Active Campaign API. Deal custom fields are separate from the contact fields of FieldsService.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#list-all-custom-field-meta
+type DealFieldsService service
+
+// CustomFieldMeta is the definition of a deal or account custom field.
+// Numeric settings are sent and returned by Active Campaign as numbers.
+type CustomFieldMeta struct {
+	FieldLabel string    `json:"fieldLabel,omitempty"`
+	FieldType  FieldType `json:"fieldType,omitempty"`
+	// FieldOptions are the values a dropdown, multiselect, radio or checkbox field can take.
+	FieldOptions []string `json:"fieldOptions,omitempty"`
+	FieldDefault string   `json:"fieldDefault,omitempty"`
+	// FieldDefaultCurrency is the default currency of a currency field, e.g. "usd".
+	FieldDefaultCurrency string      `json:"fieldDefaultCurrency,omitempty"`
+	IsFormVisible        json.Number `json:"isFormVisible,omitempty"`
+	IsRequired           json.Number `json:"isRequired,omitempty"`
+	DisplayOrder         json.Number `json:"displayOrder,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Personalization  string      `json:"personalization,omitempty"`
+	KnownFieldID     json.Number `json:"knownFieldId,omitempty"`
+	HideFieldFlag    json.Number `json:"hideFieldFlag,omitempty"`
+	CreatedTimestamp string      `json:"createdTimestamp,omitempty"`
+	UpdatedTimestamp string      `json:"updatedTimestamp,omitempty"`
+	ID               string      `json:"id,omitempty"`
+}
+
+// DealFieldValue is the value of a deal custom field on a deal.
+// FieldValue may be set to a string, or to a typed value such as DateValue, MultiValue or CurrencyValue
+// to get the encoding right. Currency values also need FieldCurrency.
+type DealFieldValue struct {
+	DealID        json.Number `json:"dealId,omitempty"`
+	CustomFieldID json.Number `json:"customFieldId,omitempty"`
+	FieldValue    interface{} `json:"fieldValue"`
+	FieldCurrency string      `json:"fieldCurrency,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	CreatedTimestamp string `json:"createdTimestamp,omitempty"`
+	UpdatedTimestamp string `json:"updatedTimestamp,omitempty"`
+
+	// ID identifies the value. It is also required for each value passed to BulkUpdateValues.
+	ID string `json:"id,omitempty"`
+}
+
+// MarshalJSON encodes v, sending a MultiValue as the array of options deal custom fields expect.
+func (v DealFieldValue) MarshalJSON() ([]byte, error) {
+	type dealFieldValue DealFieldValue
+	fv := dealFieldValue(v)
+	fv.FieldValue = customObjectFieldValue(fv.FieldValue)
+	return json.Marshal(fv)
+}
+
+// DecodeValue decodes the value of v into dst, which should be a pointer to a typed value such as
+// *DateValue, *DatetimeValue, *MultiValue or *CurrencyValue, or any other type the value can be decoded to.
+func (v *DealFieldValue) DecodeValue(dst interface{}) error {
+	return decodeFieldValue(v.FieldValue, dst)
+}
+
+// customObjectFieldValue converts a typed field value to the encoding used by deal and account custom fields,
+// which take multiselect options as an array rather than a ||option1||option2|| string.
+func customObjectFieldValue(value interface{}) interface{} {
+	if m, ok := value.(MultiValue); ok {
+		return []string(m)
+	}
+	return value
+}
+
+// CreateDealFieldRequest is the request body used for creating a deal custom field.
+type CreateDealFieldRequest struct {
+	Field *CustomFieldMeta `json:"dealCustomFieldMetum"`
+}
+
+// UpdateDealFieldRequest is the request body used for updating a deal custom field.
+type UpdateDealFieldRequest struct {
+	Field *CustomFieldMeta `json:"dealCustomFieldMetum"`
+}
+
+// DealFieldResponse is the response body returned from creating, retrieving or updating a deal custom field.
+type DealFieldResponse struct {
+	Field *CustomFieldMeta `json:"dealCustomFieldMetum"`
+}
+
+// ListDealFieldsResponse is the response body returned from listing deal custom fields.
+type ListDealFieldsResponse struct {
+	Fields []*CustomFieldMeta `json:"dealCustomFieldMeta"`
+	Meta   *Meta              `json:"meta"`
+}
+
+// Create a deal custom field.
+func (s *DealFieldsService) Create(ctx context.Context, field *CreateDealFieldRequest) (*DealFieldResponse, *Response, error) {
+	u := "dealCustomFieldMeta"
+	req, err := s.client.NewRequest(http.MethodPost, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a deal custom field.
+func (s *DealFieldsService) Retrieve(ctx context.Context, id string) (*DealFieldResponse, *Response, error) {
+	u := "dealCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a deal custom field.
+func (s *DealFieldsService) Update(ctx context.Context, id string, field *UpdateDealFieldRequest) (*DealFieldResponse, *Response, error) {
+	u := "dealCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a deal custom field.
+func (s *DealFieldsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "dealCustomFieldMeta/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List deal custom fields, one page at a time. Use ListAllPages to walk every page.
+func (s *DealFieldsService) List(ctx context.Context, opts *ListOptions) (*ListDealFieldsResponse, *Response, error) {
+	u, err := addOptions("dealCustomFieldMeta", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListDealFieldsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CreateDealFieldValueRequest is the request body used for setting a deal custom field value.
+type CreateDealFieldValueRequest struct {
+	FieldValue *DealFieldValue `json:"dealCustomFieldDatum"`
+}
+
+// UpdateDealFieldValueRequest is the request body used for updating a deal custom field value.
+type UpdateDealFieldValueRequest struct {
+	FieldValue *DealFieldValue `json:"dealCustomFieldDatum"`
+}
+
+// DealFieldValueResponse is the response body returned from creating, retrieving or updating a deal custom field value.
+type DealFieldValueResponse struct {
+	FieldValue *DealFieldValue `json:"dealCustomFieldDatum"`
+}
+
+// ListDealFieldValuesOptions specifies the optional parameters to DealFieldsService.ListValues.
+type ListDealFieldValuesOptions struct {
+	ListOptions
+
+	// DealID filters values by the deal they belong to.
+	DealID string `url:"filters[dealId],omitempty"`
+}
+
+// ListDealFieldValuesResponse is the response body returned from listing deal custom field values.
+type ListDealFieldValuesResponse struct {
+	FieldValues []*DealFieldValue `json:"dealCustomFieldData"`
+	Meta        *Meta             `json:"meta"`
+}
+
+// CreateValue sets the value of a deal custom field on a deal.
+func (s *DealFieldsService) CreateValue(ctx context.Context, fieldValue *CreateDealFieldValueRequest) (*DealFieldValueResponse, *Response, error) {
+	u := "dealCustomFieldData"
+	req, err := s.client.NewRequest(http.MethodPost, u, fieldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// RetrieveValue retrieves a deal custom field value.
+func (s *DealFieldsService) RetrieveValue(ctx context.Context, id string) (*DealFieldValueResponse, *Response, error) {
+	u := "dealCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateValue updates a deal custom field value.
+func (s *DealFieldsService) UpdateValue(ctx context.Context, id string, fieldValue *UpdateDealFieldValueRequest) (*DealFieldValueResponse, *Response, error) {
+	u := "dealCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, fieldValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealFieldValueResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DeleteValue deletes a deal custom field value.
+func (s *DealFieldsService) DeleteValue(ctx context.Context, id string) (*Response, error) {
+	u := "dealCustomFieldData/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListValues lists deal custom field values, one page at a time. Use ListAllPages to walk every page.
+func (s *DealFieldsService) ListValues(ctx context.Context, opts *ListDealFieldValuesOptions) (*ListDealFieldValuesResponse, *Response, error) {
+	u, err := addOptions("dealCustomFieldData", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListDealFieldValuesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// BulkCreateValues sets many deal custom field values, possibly on different deals, in a single request.
+func (s *DealFieldsService) BulkCreateValues(ctx context.Context, fieldValues []*DealFieldValue) (*Response, error) {
+	u := "dealCustomFieldData/bulkCreate"
+	req, err := s.client.NewRequest(http.MethodPost, u, fieldValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// BulkUpdateValues updates many existing deal custom field values in a single request.
+// Each value must have its ID set.
+func (s *DealFieldsService) BulkUpdateValues(ctx context.Context, fieldValues []*DealFieldValue) (*Response, error) {
+	u := "dealCustomFieldData/bulkUpdate"
+	req, err := s.client.NewRequest(http.MethodPatch, u, fieldValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
@@ -0,0 +1,360 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDealFieldsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateDealFieldRequest{
+		&CustomFieldMeta{
+			FieldLabel:           "Contract Value",
+			FieldType:            FieldTypeCurrency,
+			FieldDefaultCurrency: "usd",
+			IsFormVisible:        "1",
+			IsRequired:           "0",
+		},
+	}
+
+	mux.HandleFunc("/api/3/dealCustomFieldMeta", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		want := `{"dealCustomFieldMetum":{"fieldLabel":"Contract Value","fieldType":"currency","fieldDefaultCurrency":"usd","isFormVisible":1,"isRequired":0}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealCustomFieldMetum": {
+					"fieldLabel": "Contract Value",
+					"fieldType": "currency",
+					"fieldDefaultCurrency": "usd",
+					"isFormVisible": 1,
+					"isRequired": 0,
+					"displayOrder": 1,
+					"personalization": "",
+					"knownFieldId": null,
+					"hideFieldFlag": 0,
+					"createdTimestamp": "2020-06-24 12:00:00",
+					"updatedTimestamp": "2020-06-24 12:00:00",
+					"id": "3"
+				}
+			}`)
+	})
+
+	field, _, err := c.DealFields.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("DealFields.Create returned error: %v", err)
+	}
+
+	want := &DealFieldResponse{
+		Field: &CustomFieldMeta{
+			FieldLabel:           "Contract Value",
+			FieldType:            FieldTypeCurrency,
+			FieldDefaultCurrency: "usd",
+			IsFormVisible:        "1",
+			IsRequired:           "0",
+			DisplayOrder:         "1",
+			HideFieldFlag:        "0",
+			CreatedTimestamp:     "2020-06-24 12:00:00",
+			UpdatedTimestamp:     "2020-06-24 12:00:00",
+			ID:                   "3",
+		},
+	}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("DealFields.Create returned %+v, want %+v", field, want)
+	}
+}
+
+func TestDealFieldsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldMeta/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldMetum": {"fieldLabel": "Regions", "fieldType": "multiselect", "fieldOptions": ["EU", "US"], "id": "3"}}`)
+	})
+
+	field, _, err := c.DealFields.Retrieve(ctx, "3")
+	if err != nil {
+		t.Fatalf("DealFields.Retrieve returned error: %v", err)
+	}
+
+	want := &DealFieldResponse{Field: &CustomFieldMeta{FieldLabel: "Regions", FieldType: FieldTypeMultiselect, FieldOptions: []string{"EU", "US"}, ID: "3"}}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("DealFields.Retrieve returned %+v, want %+v", field, want)
+	}
+}
+
+func TestDealFieldsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateDealFieldRequest{&CustomFieldMeta{FieldLabel: "Sales Regions"}}
+
+	mux.HandleFunc("/api/3/dealCustomFieldMeta/3", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateDealFieldRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldMetum": {"fieldLabel": "Sales Regions", "id": "3"}}`)
+	})
+
+	field, _, err := c.DealFields.Update(ctx, "3", input)
+	if err != nil {
+		t.Fatalf("DealFields.Update returned error: %v", err)
+	}
+
+	want := &DealFieldResponse{Field: &CustomFieldMeta{FieldLabel: "Sales Regions", ID: "3"}}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("DealFields.Update returned %+v, want %+v", field, want)
+	}
+}
+
+func TestDealFieldsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldMeta/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.DealFields.Delete(ctx, "3")
+	if err != nil {
+		t.Errorf("DealFields.Delete returned error: %v", err)
+	}
+}
+
+func TestDealFieldsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldMeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"limit": "1"})
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldMeta": [{"fieldLabel": "Contract Value", "id": "3"}], "meta": {"total": 2}}`)
+	})
+
+	fields, resp, err := c.DealFields.List(ctx, &ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("DealFields.List returned error: %v", err)
+	}
+
+	want := &ListDealFieldsResponse{
+		Fields: []*CustomFieldMeta{{FieldLabel: "Contract Value", ID: "3"}},
+		Meta:   &Meta{Total: "2"},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("DealFields.List returned %+v, want %+v", fields, want)
+	}
+	if resp.Total != 2 || resp.NextOffset != 1 {
+		t.Errorf("DealFields.List returned Total %d and NextOffset %d, want 2 and 1", resp.Total, resp.NextOffset)
+	}
+}
+
+func TestDealFieldsService_CreateValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		want := `{"dealCustomFieldDatum":{"dealId":45,"customFieldId":3,"fieldValue":1599,"fieldCurrency":"usd"}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealCustomFieldDatum": {
+					"dealId": 45,
+					"customFieldId": 3,
+					"fieldValue": 1599,
+					"fieldCurrency": "usd",
+					"createdTimestamp": "2020-06-24 12:00:00",
+					"updatedTimestamp": "2020-06-24 12:00:00",
+					"id": "9"
+				}
+			}`)
+	})
+
+	input := &CreateDealFieldValueRequest{
+		&DealFieldValue{DealID: "45", CustomFieldID: "3", FieldValue: CurrencyValue(1599), FieldCurrency: "usd"},
+	}
+	fieldValue, _, err := c.DealFields.CreateValue(ctx, input)
+	if err != nil {
+		t.Fatalf("DealFields.CreateValue returned error: %v", err)
+	}
+
+	if fieldValue.FieldValue.ID != "9" || fieldValue.FieldValue.DealID != "45" {
+		t.Errorf("DealFields.CreateValue returned %+v, want value 9 on deal 45", fieldValue.FieldValue)
+	}
+	var contractValue CurrencyValue
+	if err := fieldValue.FieldValue.DecodeValue(&contractValue); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if contractValue != 1599 {
+		t.Errorf("DealFields.CreateValue value = %v, want 15.99", contractValue)
+	}
+}
+
+func TestDealFieldsService_RetrieveValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldDatum": {"dealId": 45, "customFieldId": 4, "fieldValue": ["EU", "US"], "id": "10"}}`)
+	})
+
+	fieldValue, _, err := c.DealFields.RetrieveValue(ctx, "10")
+	if err != nil {
+		t.Fatalf("DealFields.RetrieveValue returned error: %v", err)
+	}
+
+	var regions MultiValue
+	if err := fieldValue.FieldValue.DecodeValue(&regions); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if want := (MultiValue{"EU", "US"}); !reflect.DeepEqual(regions, want) {
+		t.Errorf("DealFields.RetrieveValue value = %v, want %v", regions, want)
+	}
+}
+
+func TestDealFieldsService_UpdateValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData/11", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "PUT")
+		want := `{"dealCustomFieldDatum":{"fieldValue":"2020-07-01"}}` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldDatum": {"dealId": 45, "customFieldId": 5, "fieldValue": "2020-07-01", "id": "11"}}`)
+	})
+
+	closeDate := DateValue(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC))
+	fieldValue, _, err := c.DealFields.UpdateValue(ctx, "11", &UpdateDealFieldValueRequest{&DealFieldValue{FieldValue: closeDate}})
+	if err != nil {
+		t.Fatalf("DealFields.UpdateValue returned error: %v", err)
+	}
+
+	want := &DealFieldValueResponse{FieldValue: &DealFieldValue{DealID: "45", CustomFieldID: "5", FieldValue: "2020-07-01", ID: "11"}}
+	if !reflect.DeepEqual(fieldValue, want) {
+		t.Errorf("DealFields.UpdateValue returned %+v, want %+v", fieldValue, want)
+	}
+}
+
+func TestDealFieldsService_DeleteValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.DealFields.DeleteValue(ctx, "11")
+	if err != nil {
+		t.Errorf("DealFields.DeleteValue returned error: %v", err)
+	}
+}
+
+func TestDealFieldsService_ListValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[dealId]": "45"})
+		_, _ = fmt.Fprint(w, `{"dealCustomFieldData": [{"dealId": 45, "customFieldId": 3, "fieldValue": 1599, "id": "9"}], "meta": {"total": 1}}`)
+	})
+
+	fieldValues, resp, err := c.DealFields.ListValues(ctx, &ListDealFieldValuesOptions{DealID: "45"})
+	if err != nil {
+		t.Fatalf("DealFields.ListValues returned error: %v", err)
+	}
+
+	want := &ListDealFieldValuesResponse{
+		FieldValues: []*DealFieldValue{{DealID: "45", CustomFieldID: "3", FieldValue: 1599.0, ID: "9"}},
+		Meta:        &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(fieldValues, want) {
+		t.Errorf("DealFields.ListValues returned %+v, want %+v", fieldValues, want)
+	}
+	if resp.Total != 1 {
+		t.Errorf("DealFields.ListValues returned Total %d, want 1", resp.Total)
+	}
+}
+
+func TestDealFieldsService_BulkCreateValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData/bulkCreate", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		want := `[{"dealId":45,"customFieldId":4,"fieldValue":["EU","US"]},{"dealId":46,"customFieldId":3,"fieldValue":50000,"fieldCurrency":"eur"}]` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.DealFields.BulkCreateValues(ctx, []*DealFieldValue{
+		{DealID: "45", CustomFieldID: "4", FieldValue: MultiValue{"EU", "US"}},
+		{DealID: "46", CustomFieldID: "3", FieldValue: CurrencyValue(50000), FieldCurrency: "eur"},
+	})
+	if err != nil {
+		t.Errorf("DealFields.BulkCreateValues returned error: %v", err)
+	}
+}
+
+func TestDealFieldsService_BulkUpdateValues(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealCustomFieldData/bulkUpdate", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "PATCH")
+		want := `[{"fieldValue":"2020-07-01","id":"11"},{"fieldValue":"2020-08-01","id":"12"}]` + "\n"
+		if string(body) != want {
+			t.Errorf("Request body = %s, want %s", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.DealFields.BulkUpdateValues(ctx, []*DealFieldValue{
+		{ID: "11", FieldValue: DateValue(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC))},
+		{ID: "12", FieldValue: DateValue(time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC))},
+	})
+	if err != nil {
+		t.Errorf("DealFields.BulkUpdateValues returned error: %v", err)
+	}
+}
@@ -0,0 +1,352 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// DealsService handles communication with deal related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#deal
+type DealsService service
+
+// DealStatus is the outcome of a deal.
+type DealStatus string
+
+const (
+	DealStatusOpen DealStatus = "0"
+	DealStatusWon  DealStatus = "1"
+	DealStatusLost DealStatus = "2"
+)
+
+// String returns a readable name for the status.
+func (s DealStatus) String() string {
+	switch s {
+	case DealStatusOpen:
+		return "open"
+	case DealStatusWon:
+		return "won"
+	case DealStatusLost:
+		return "lost"
+	}
+	return string(s)
+}
+
+// UnmarshalJSON decodes a deal status. See unmarshalStatus.
+func (s *DealStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalStatus(data, (*string)(s))
+}
+
+// Deal is an opportunity tracked through the stages of a pipeline.
+// Value is expressed in cents of Currency, e.g. "45600" for 456.00 USD.
+type Deal struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Contact     string     `json:"contact,omitempty"`
+	Account     string     `json:"account,omitempty"`
+	Value       string     `json:"value,omitempty"`
+	Currency    string     `json:"currency,omitempty"`
+	Group       string     `json:"group,omitempty"`
+	Stage       string     `json:"stage,omitempty"`
+	Owner       string     `json:"owner,omitempty"`
+	Percent     string     `json:"percent,omitempty"`
+	Status      DealStatus `json:"status,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Hash                string     `json:"hash,omitempty"`
+	Cdate               string     `json:"cdate,omitempty"`
+	Mdate               string     `json:"mdate,omitempty"`
+	Edate               string     `json:"edate,omitempty"`
+	NextDate            string     `json:"nextdate,omitempty"`
+	NextTaskID          string     `json:"nexttaskid,omitempty"`
+	WinProbability      string     `json:"winProbability,omitempty"`
+	WinProbabilityMdate string     `json:"winProbabilityMdate,omitempty"`
+	ActivityCount       string     `json:"activitycount,omitempty"`
+	Organization        string     `json:"organization,omitempty"`
+	IsDisabled          string     `json:"isDisabled,omitempty"`
+	Links               *DealLinks `json:"links,omitempty"`
+	ID                  string     `json:"id,omitempty"`
+}
+
+// DealLinks are the related resource URLs returned with a deal.
+type DealLinks struct {
+	DealActivities      string `json:"dealActivities"`
+	Contact             string `json:"contact"`
+	ContactDeals        string `json:"contactDeals"`
+	Group               string `json:"group"`
+	NextTask            string `json:"nextTask"`
+	Notes               string `json:"notes"`
+	Account             string `json:"account"`
+	CustomerAccount     string `json:"customerAccount"`
+	Organization        string `json:"organization"`
+	Owner               string `json:"owner"`
+	ScoreValues         string `json:"scoreValues"`
+	Stage               string `json:"stage"`
+	Tasks               string `json:"tasks"`
+	DealCustomFieldData string `json:"dealCustomFieldData"`
+}
+
+// CreateDealRequest is the request body used for creating a deal.
+type CreateDealRequest struct {
+	Deal *Deal `json:"deal"`
+}
+
+// UpdateDealRequest is the request body used for updating a deal.
+type UpdateDealRequest struct {
+	Deal *Deal `json:"deal"`
+}
+
+// DealResponse is the response body returned from creating, retrieving or updating a deal.
+type DealResponse struct {
+	Contacts []*Contact `json:"contacts,omitempty"`
+	Deal     *Deal      `json:"deal"`
+}
+
+// ListDealsOptions specifies the optional parameters to DealsService.List.
+type ListDealsOptions struct {
+	ListOptions
+
+	// Search filters deals by title, contact or organization. SearchField narrows it to one of
+	// "title", "contact" or "org".
+	Search      string     `url:"filters[search],omitempty"`
+	SearchField string     `url:"filters[search_field],omitempty"`
+	Title       string     `url:"filters[title],omitempty"`
+	Stage       string     `url:"filters[stage],omitempty"`
+	Group       string     `url:"filters[group],omitempty"`
+	Owner       string     `url:"filters[owner],omitempty"`
+	Status      DealStatus `url:"filters[status],omitempty"`
+	Tag         string     `url:"filters[tag],omitempty"`
+	// Organization filters deals by the ID of their account.
+	Organization string `url:"filters[organization],omitempty"`
+	// MinimumValue and MaximumValue filter deals by value, in dollars.
+	MinimumValue string `url:"filters[minimum_value],omitempty"`
+	MaximumValue string `url:"filters[maximum_value],omitempty"`
+
+	// Date filters accept a date (YYYY-MM-DD) or a datetime.
+	CreatedBefore string `url:"filters[created_before],omitempty"`
+	CreatedAfter  string `url:"filters[created_after],omitempty"`
+	UpdatedBefore string `url:"filters[updated_before],omitempty"`
+	UpdatedAfter  string `url:"filters[updated_after],omitempty"`
+
+	// Orders sort the results. Each accepts "ASC" or "DESC".
+	OrderByTitle    string `url:"orders[title],omitempty"`
+	OrderByValue    string `url:"orders[value],omitempty"`
+	OrderByCdate    string `url:"orders[cdate],omitempty"`
+	OrderByNextDate string `url:"orders[nextdate],omitempty"`
+}
+
+// ListDealsResponse is the response body returned from listing deals.
+type ListDealsResponse struct {
+	Deals []*Deal `json:"deals"`
+	Meta  *Meta   `json:"meta"`
+}
+
+// Create a deal.
+func (s *DealsService) Create(ctx context.Context, deal *CreateDealRequest) (*DealResponse, *Response, error) {
+	u := "deals"
+	req, err := s.client.NewRequest(http.MethodPost, u, deal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a deal.
+func (s *DealsService) Retrieve(ctx context.Context, id string) (*DealResponse, *Response, error) {
+	u := "deals/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a deal.
+func (s *DealsService) Update(ctx context.Context, id string, deal *UpdateDealRequest) (*DealResponse, *Response, error) {
+	u := "deals/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, deal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a deal.
+func (s *DealsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "deals/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List deals, one page at a time. Use ListAllPages to walk every page.
+func (s *DealsService) List(ctx context.Context, opts *ListDealsOptions) (*ListDealsResponse, *Response, error) {
+	u, err := addOptions("deals", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListDealsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// DealNote is a note attached to a deal.
+type DealNote struct {
+	Note string `json:"note"`
+
+	// Read-only fields returned by Active Campaign.
+	RelID   string `json:"relid,omitempty"`
+	RelType string `json:"reltype,omitempty"`
+	UserID  string `json:"userid,omitempty"`
+	Cdate   string `json:"cdate,omitempty"`
+	Mdate   string `json:"mdate,omitempty"`
+	ID      string `json:"id,omitempty"`
+}
+
+// DealNoteRequest is the request body used for creating or updating a deal note.
+type DealNoteRequest struct {
+	Note *DealNote `json:"note"`
+}
+
+// DealNoteResponse is the response body returned from creating or updating a deal note.
+type DealNoteResponse struct {
+	Deals []*Deal   `json:"deals,omitempty"`
+	Note  *DealNote `json:"note"`
+}
+
+// ListDealNotesResponse is the response body returned from listing the notes of a deal.
+type ListDealNotesResponse struct {
+	Notes []*DealNote `json:"notes"`
+	Meta  *Meta       `json:"meta,omitempty"`
+}
+
+// CreateNote adds a note to a deal.
+func (s *DealsService) CreateNote(ctx context.Context, dealID string, note *DealNoteRequest) (*DealNoteResponse, *Response, error) {
+	u := "deals/" + dealID + "/notes"
+	req, err := s.client.NewRequest(http.MethodPost, u, note)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealNoteResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateNote updates a note of a deal.
+func (s *DealsService) UpdateNote(ctx context.Context, dealID, noteID string, note *DealNoteRequest) (*DealNoteResponse, *Response, error) {
+	u := "deals/" + dealID + "/notes/" + noteID
+	req, err := s.client.NewRequest(http.MethodPut, u, note)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &DealNoteResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// ListNotes lists the notes of a deal.
+func (s *DealsService) ListNotes(ctx context.Context, dealID string) (*ListDealNotesResponse, *Response, error) {
+	u := "deals/" + dealID + "/notes"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListDealNotesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// BulkUpdateDeal is the change applied to a single deal by DealsService.BulkUpdate.
+// Only the fields that are set are changed.
+type BulkUpdateDeal struct {
+	ID     string     `json:"id"`
+	Stage  string     `json:"stage,omitempty"`
+	Owner  string     `json:"owner,omitempty"`
+	Status DealStatus `json:"status,omitempty"`
+}
+
+// BulkUpdateDealsRequest is the request body used for updating many deals at once.
+type BulkUpdateDealsRequest struct {
+	Deals []*BulkUpdateDeal `json:"deals"`
+}
+
+// BulkUpdateDealsResponse is the response body returned from updating many deals at once.
+type BulkUpdateDealsResponse struct {
+	Deals []*Deal `json:"deals,omitempty"`
+}
+
+// BulkUpdate changes the stage, owner or status of many deals in a single request,
+// for example to move every deal of a stage to another one.
+func (s *DealsService) BulkUpdate(ctx context.Context, deals *BulkUpdateDealsRequest) (*BulkUpdateDealsResponse, *Response, error) {
+	u := "deals/bulkUpdate"
+	req, err := s.client.NewRequest(http.MethodPut, u, deals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &BulkUpdateDealsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
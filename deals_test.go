@@ -0,0 +1,320 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDealsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateDealRequest{
+		&Deal{
+			Title:    "Enterprise plan",
+			Contact:  "51",
+			Value:    "45600",
+			Currency: "usd",
+			Group:    "1",
+			Stage:    "1",
+			Owner:    "1",
+			Status:   DealStatusOpen,
+		},
+	}
+
+	mux.HandleFunc("/api/3/deals", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateDealRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"deal": {
+					"title": "Enterprise plan",
+					"contact": "51",
+					"value": "45600",
+					"currency": "usd",
+					"group": "1",
+					"stage": "1",
+					"owner": "1",
+					"status": 0,
+					"hash": "88b2b0ec",
+					"cdate": "2020-06-24T12:00:00-05:00",
+					"links": {
+						"notes": "https://your_base_url.api-us1.com/api/3/deals/45/notes",
+						"stage": "https://your_base_url.api-us1.com/api/3/deals/45/stage"
+					},
+					"id": "45"
+				}
+			}`)
+	})
+
+	deal, _, err := c.Deals.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Deals.Create returned error: %v", err)
+	}
+
+	want := &DealResponse{
+		Deal: &Deal{
+			Title:    "Enterprise plan",
+			Contact:  "51",
+			Value:    "45600",
+			Currency: "usd",
+			Group:    "1",
+			Stage:    "1",
+			Owner:    "1",
+			Status:   DealStatusOpen,
+			Hash:     "88b2b0ec",
+			Cdate:    "2020-06-24T12:00:00-05:00",
+			Links: &DealLinks{
+				Notes: "https://your_base_url.api-us1.com/api/3/deals/45/notes",
+				Stage: "https://your_base_url.api-us1.com/api/3/deals/45/stage",
+			},
+			ID: "45",
+		},
+	}
+	if !reflect.DeepEqual(deal, want) {
+		t.Errorf("Deals.Create returned %+v, want %+v", deal, want)
+	}
+}
+
+func TestDealsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals/45", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"deal": {"title": "Enterprise plan", "stage": "2", "status": "1", "id": "45"}}`)
+	})
+
+	deal, _, err := c.Deals.Retrieve(ctx, "45")
+	if err != nil {
+		t.Fatalf("Deals.Retrieve returned error: %v", err)
+	}
+
+	want := &DealResponse{Deal: &Deal{Title: "Enterprise plan", Stage: "2", Status: DealStatusWon, ID: "45"}}
+	if !reflect.DeepEqual(deal, want) {
+		t.Errorf("Deals.Retrieve returned %+v, want %+v", deal, want)
+	}
+	if got := deal.Deal.Status.String(); got != "won" {
+		t.Errorf("Deal status String() = %q, want %q", got, "won")
+	}
+}
+
+func TestDealsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateDealRequest{&Deal{Stage: "3", Status: DealStatusLost}}
+
+	mux.HandleFunc("/api/3/deals/45", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateDealRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"deal": {"title": "Enterprise plan", "stage": "3", "status": "2", "id": "45"}}`)
+	})
+
+	deal, _, err := c.Deals.Update(ctx, "45", input)
+	if err != nil {
+		t.Fatalf("Deals.Update returned error: %v", err)
+	}
+
+	want := &DealResponse{Deal: &Deal{Title: "Enterprise plan", Stage: "3", Status: DealStatusLost, ID: "45"}}
+	if !reflect.DeepEqual(deal, want) {
+		t.Errorf("Deals.Update returned %+v, want %+v", deal, want)
+	}
+}
+
+func TestDealsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals/45", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Deals.Delete(ctx, "45")
+	if err != nil {
+		t.Errorf("Deals.Delete returned error: %v", err)
+	}
+}
+
+func TestDealsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"limit":                   "50",
+			"filters[stage]":          "2",
+			"filters[group]":          "1",
+			"filters[owner]":          "3",
+			"filters[status]":         "0",
+			"filters[search]":         "enterprise",
+			"filters[created_after]":  "2020-01-01",
+			"filters[updated_before]": "2020-07-01",
+			"orders[value]":           "DESC",
+		})
+		_, _ = fmt.Fprint(w, `{"deals": [{"title": "Enterprise plan", "stage": "2", "status": "0", "id": "45"}], "meta": {"total": "51"}}`)
+	})
+
+	opts := &ListDealsOptions{
+		ListOptions:   ListOptions{Limit: 50},
+		Stage:         "2",
+		Group:         "1",
+		Owner:         "3",
+		Status:        DealStatusOpen,
+		Search:        "enterprise",
+		CreatedAfter:  "2020-01-01",
+		UpdatedBefore: "2020-07-01",
+		OrderByValue:  "DESC",
+	}
+	deals, resp, err := c.Deals.List(ctx, opts)
+	if err != nil {
+		t.Fatalf("Deals.List returned error: %v", err)
+	}
+
+	want := &ListDealsResponse{
+		Deals: []*Deal{{Title: "Enterprise plan", Stage: "2", Status: DealStatusOpen, ID: "45"}},
+		Meta:  &Meta{Total: "51"},
+	}
+	if !reflect.DeepEqual(deals, want) {
+		t.Errorf("Deals.List returned %+v, want %+v", deals, want)
+	}
+	if resp.NextOffset != 50 {
+		t.Errorf("Deals.List NextOffset = %d, want 50", resp.NextOffset)
+	}
+}
+
+func TestDealsService_CreateNote(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DealNoteRequest{&DealNote{Note: "Sent the proposal."}}
+
+	mux.HandleFunc("/api/3/deals/45/notes", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DealNoteRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"note": {"note": "Sent the proposal.", "relid": "45", "reltype": "Deal", "userid": "1", "id": "2"}}`)
+	})
+
+	note, _, err := c.Deals.CreateNote(ctx, "45", input)
+	if err != nil {
+		t.Fatalf("Deals.CreateNote returned error: %v", err)
+	}
+
+	want := &DealNoteResponse{Note: &DealNote{Note: "Sent the proposal.", RelID: "45", RelType: "Deal", UserID: "1", ID: "2"}}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Deals.CreateNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestDealsService_UpdateNote(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals/45/notes/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		_, _ = fmt.Fprint(w, `{"note": {"note": "Sent the revised proposal.", "id": "2"}}`)
+	})
+
+	note, _, err := c.Deals.UpdateNote(ctx, "45", "2", &DealNoteRequest{&DealNote{Note: "Sent the revised proposal."}})
+	if err != nil {
+		t.Fatalf("Deals.UpdateNote returned error: %v", err)
+	}
+
+	want := &DealNoteResponse{Note: &DealNote{Note: "Sent the revised proposal.", ID: "2"}}
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Deals.UpdateNote returned %+v, want %+v", note, want)
+	}
+}
+
+func TestDealsService_ListNotes(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/deals/45/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"notes": [{"note": "Sent the proposal.", "id": "2"}]}`)
+	})
+
+	notes, _, err := c.Deals.ListNotes(ctx, "45")
+	if err != nil {
+		t.Fatalf("Deals.ListNotes returned error: %v", err)
+	}
+
+	want := &ListDealNotesResponse{Notes: []*DealNote{{Note: "Sent the proposal.", ID: "2"}}}
+	if !reflect.DeepEqual(notes, want) {
+		t.Errorf("Deals.ListNotes returned %+v, want %+v", notes, want)
+	}
+}
+
+func TestDealsService_BulkUpdate(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &BulkUpdateDealsRequest{
+		Deals: []*BulkUpdateDeal{
+			{ID: "45", Stage: "3"},
+			{ID: "46", Stage: "3", Owner: "2"},
+		},
+	}
+
+	mux.HandleFunc("/api/3/deals/bulkUpdate", func(w http.ResponseWriter, r *http.Request) {
+		v := new(BulkUpdateDealsRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"deals": [{"stage": "3", "id": "45"}, {"stage": "3", "owner": "2", "id": "46"}]}`)
+	})
+
+	deals, _, err := c.Deals.BulkUpdate(ctx, input)
+	if err != nil {
+		t.Fatalf("Deals.BulkUpdate returned error: %v", err)
+	}
+
+	want := &BulkUpdateDealsResponse{Deals: []*Deal{{Stage: "3", ID: "45"}, {Stage: "3", Owner: "2", ID: "46"}}}
+	if !reflect.DeepEqual(deals, want) {
+		t.Errorf("Deals.BulkUpdate returned %+v, want %+v", deals, want)
+	}
+}
+
+func TestDealStatus_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Status DealStatus `json:"status"`
+	}
+	for in, want := range map[string]DealStatus{`{"status": 2}`: DealStatusLost, `{"status": "2"}`: DealStatusLost, `{"status": ""}`: "", `{"status": null}`: ""} {
+		v.Status = ""
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %v", in, err)
+		}
+		if v.Status != want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", in, v.Status, want)
+		}
+	}
+}
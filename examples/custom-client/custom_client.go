@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	ac "github.com/benkrig/active-campaign-sdk-go"
 	"net/http"
 	"os"
@@ -25,7 +26,7 @@ func main() {
 		panic(err)
 	}
 
-	_, _, err = a.Tags.ListAll()
+	_, _, err = a.Tags.ListAll(context.Background(), nil)
 	if err != nil {
 		panic(err)
 	}
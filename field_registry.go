@@ -0,0 +1,130 @@
+package active_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultFieldCacheTTL is how long field definitions are cached when ClientOpts.FieldCacheTTL is zero.
+const DefaultFieldCacheTTL = 10 * time.Minute
+
+// ErrFieldNotFound is returned when a custom field cannot be resolved by personalization tag or title.
+var ErrFieldNotFound = errors.New("custom field not found")
+
+// FieldRegistry resolves custom contact fields by personalization tag (e.g. %COMPANY_SIZE%) or title.
+// Field definitions are loaded on first use and cached until the TTL passes or Refresh is called.
+// It is safe for concurrent use and shared by every service on a Client.
+type FieldRegistry struct {
+	client *Client
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	loadedAt  time.Time
+	byPerstag map[string]*Field // normalized perstag -> field
+	byTitle   map[string]*Field // lower-cased title -> field
+}
+
+func newFieldRegistry(c *Client, ttl time.Duration) *FieldRegistry {
+	if ttl <= 0 {
+		ttl = DefaultFieldCacheTTL
+	}
+	return &FieldRegistry{client: c, ttl: ttl}
+}
+
+// normalizePerstag strips the surrounding % signs of a personalization tag and upper-cases it.
+func normalizePerstag(s string) string {
+	return strings.ToUpper(strings.Trim(s, "%"))
+}
+
+// Refresh reloads every field definition from Active Campaign.
+func (r *FieldRegistry) Refresh(ctx context.Context) error {
+	var fields []*Field
+	err := ListAllPages(ctx, &ListOptions{Limit: 100}, func(opts *ListOptions) (*Response, error) {
+		page, resp, err := r.client.Fields.List(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		fields = append(fields, page.Fields...)
+		return resp, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	byPerstag := make(map[string]*Field, len(fields))
+	byTitle := make(map[string]*Field, len(fields))
+	for _, f := range fields {
+		if f.Perstag != "" {
+			byPerstag[normalizePerstag(f.Perstag)] = f
+		}
+		byTitle[strings.ToLower(f.Title)] = f
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byPerstag, r.byTitle, r.loadedAt = byPerstag, byTitle, time.Now()
+	return nil
+}
+
+// Lookup returns the field whose personalization tag or title matches name. Personalization tags may be given
+// with or without the surrounding % signs and are matched before titles; both are matched case-insensitively.
+// If no field matches, an error wrapping ErrFieldNotFound is returned.
+func (r *FieldRegistry) Lookup(ctx context.Context, name string) (*Field, error) {
+	r.mu.Lock()
+	stale := r.byPerstag == nil || time.Since(r.loadedAt) > r.ttl
+	r.mu.Unlock()
+
+	if stale {
+		if err := r.Refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if f, ok := r.byPerstag[normalizePerstag(name)]; ok {
+		return f, nil
+	}
+	if f, ok := r.byTitle[strings.ToLower(name)]; ok {
+		return f, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrFieldNotFound, name)
+}
+
+// SetFieldValue sets the value of the custom field named by personalization tag or title on a contact.
+// value may be a string or a typed value such as DateValue or MultiValue.
+func (s *ContactsService) SetFieldValue(ctx context.Context, contactID, field string, value interface{}) (*CreateCustomFieldValueResponse, *Response, error) {
+	f, err := s.client.FieldRegistry.Lookup(ctx, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.CreateCustomFieldValue(ctx, &CreateCustomFieldValueRequest{
+		&FieldValue{Contact: contactID, Field: f.ID, Value: value},
+	})
+}
+
+// GetFieldValue returns the value of the custom field named by personalization tag or title on a contact,
+// or nil if the contact has no value for it. Use FieldValue.DecodeValue to decode typed values.
+func (s *ContactsService) GetFieldValue(ctx context.Context, contactID, field string) (*FieldValue, error) {
+	f, err := s.client.FieldRegistry.Lookup(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+
+	values, _, err := s.ListContactFieldValues(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values.FieldValues {
+		if fmt.Sprint(v.Field) == f.ID {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
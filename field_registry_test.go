@@ -0,0 +1,209 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testFieldsBody = `
+{
+	"fields": [
+		{"title": "Company Size", "type": "dropdown", "perstag": "COMPANY_SIZE", "id": "1"},
+		{"title": "Birthday", "type": "date", "perstag": "BIRTHDAY", "id": "2"}
+	],
+	"meta": {"total": "2"}
+}`
+
+func TestFieldRegistry_Lookup(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	loads := 0
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		loads++
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+
+	tests := []struct {
+		name   string
+		wantID string
+	}{
+		{"%COMPANY_SIZE%", "1"},
+		{"COMPANY_SIZE", "1"},
+		{"company_size", "1"},
+		{"Birthday", "2"},
+		{"birthday", "2"},
+	}
+	for _, tt := range tests {
+		f, err := c.FieldRegistry.Lookup(ctx, tt.name)
+		if err != nil {
+			t.Errorf("Lookup(%q) returned error: %v", tt.name, err)
+			continue
+		}
+		if f.ID != tt.wantID {
+			t.Errorf("Lookup(%q) returned field %s, want %s", tt.name, f.ID, tt.wantID)
+		}
+	}
+
+	_, err := c.FieldRegistry.Lookup(ctx, "%MISSING%")
+	if !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("Lookup of a missing field returned %v, want ErrFieldNotFound", err)
+	}
+
+	if loads != 1 {
+		t.Errorf("FieldRegistry loaded fields %d times, want 1", loads)
+	}
+
+	if err := c.FieldRegistry.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+	if loads != 2 {
+		t.Errorf("FieldRegistry loaded fields %d times after Refresh, want 2", loads)
+	}
+}
+
+func TestFieldRegistry_Lookup_expires(t *testing.T) {
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{RequestsPerSecond: -1, FieldCacheTTL: time.Millisecond})
+	defer teardown()
+
+	loads := 0
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		loads++
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+
+	if _, err := c.FieldRegistry.Lookup(ctx, "BIRTHDAY"); err != nil {
+		t.Fatalf("Lookup returned error: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := c.FieldRegistry.Lookup(ctx, "BIRTHDAY"); err != nil {
+		t.Fatalf("Lookup returned error: %v", err)
+	}
+
+	if loads != 2 {
+		t.Errorf("FieldRegistry loaded fields %d times, want 2", loads)
+	}
+}
+
+func TestFieldRegistry_Lookup_loadError(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := c.FieldRegistry.Lookup(ctx, "BIRTHDAY")
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Lookup returned %v, want an *ErrorResponse", err)
+	}
+}
+
+func TestContactsService_SetFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+	mux.HandleFunc("/api/3/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateCustomFieldValueRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &FieldValue{Contact: "7", Field: "2", Value: "2020-06-24"}
+		if !reflect.DeepEqual(v.FieldValue, want) {
+			t.Errorf("Request body = %+v, want %+v", v.FieldValue, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"fieldValue": {"contact": "7", "field": "2", "value": "2020-06-24", "id": "10"}}`)
+	})
+
+	birthday := DateValue(time.Date(2020, 6, 24, 0, 0, 0, 0, time.UTC))
+	fieldValue, _, err := c.Contacts.SetFieldValue(ctx, "7", "%BIRTHDAY%", birthday)
+	if err != nil {
+		t.Fatalf("Contacts.SetFieldValue returned error: %v", err)
+	}
+	if fieldValue.FieldValue.ID != "10" {
+		t.Errorf("Contacts.SetFieldValue returned field value %s, want 10", fieldValue.FieldValue.ID)
+	}
+}
+
+func TestContactsService_SetFieldValue_unknownField(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+	mux.HandleFunc("/api/3/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Contacts.SetFieldValue wrote a value for an unknown field")
+	})
+
+	_, _, err := c.Contacts.SetFieldValue(ctx, "7", "%MISSING%", "v")
+	if !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("Contacts.SetFieldValue returned %v, want ErrFieldNotFound", err)
+	}
+}
+
+func TestContactsService_GetFieldValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+	mux.HandleFunc("/api/3/contacts/7/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"fieldValues": [
+					{"contact": "7", "field": "1", "value": "10-50", "id": "10"},
+					{"contact": "7", "field": "2", "value": "2020-06-24", "id": "11"}
+				]
+			}`)
+	})
+
+	fieldValue, err := c.Contacts.GetFieldValue(ctx, "7", "Birthday")
+	if err != nil {
+		t.Fatalf("Contacts.GetFieldValue returned error: %v", err)
+	}
+	if fieldValue == nil || fieldValue.ID != "11" {
+		t.Fatalf("Contacts.GetFieldValue returned %+v, want field value 11", fieldValue)
+	}
+
+	var birthday DateValue
+	if err := fieldValue.DecodeValue(&birthday); err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if want := time.Date(2020, 6, 24, 0, 0, 0, 0, time.UTC); !birthday.Time().Equal(want) {
+		t.Errorf("Contacts.GetFieldValue value = %v, want %v", birthday.Time(), want)
+	}
+}
+
+func TestContactsService_GetFieldValue_noValue(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, testFieldsBody)
+	})
+	mux.HandleFunc("/api/3/contacts/7/fieldValues", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"fieldValues": []}`)
+	})
+
+	fieldValue, err := c.Contacts.GetFieldValue(ctx, "7", "Birthday")
+	if err != nil {
+		t.Fatalf("Contacts.GetFieldValue returned error: %v", err)
+	}
+	if fieldValue != nil {
+		t.Errorf("Contacts.GetFieldValue returned %+v, want nil", fieldValue)
+	}
+}
@@ -0,0 +1,259 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// FieldsService handles communication with custom field related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#fields
+type FieldsService service
+
+// FieldType is the kind of input a custom field accepts.
+type FieldType string
+
+const (
+	FieldTypeText        FieldType = "text"
+	FieldTypeTextarea    FieldType = "textarea"
+	FieldTypeDate        FieldType = "date"
+	FieldTypeDatetime    FieldType = "datetime"
+	FieldTypeDropdown    FieldType = "dropdown"
+	FieldTypeMultiselect FieldType = "multiselect"
+	FieldTypeRadio       FieldType = "radio"
+	FieldTypeCheckbox    FieldType = "checkbox"
+	FieldTypeHidden      FieldType = "hidden"
+	FieldTypeCurrency    FieldType = "currency"
+	FieldTypeNumber      FieldType = "number"
+	FieldTypeNull        FieldType = "NULL"
+)
+
+// HasOptions reports whether fields of this type choose their values from a set of FieldOptions.
+func (t FieldType) HasOptions() bool {
+	switch t {
+	case FieldTypeDropdown, FieldTypeMultiselect, FieldTypeRadio, FieldTypeCheckbox:
+		return true
+	}
+	return false
+}
+
+// Field is the definition of a custom contact field.
+type Field struct {
+	Title      string    `json:"title,omitempty"`
+	Type       FieldType `json:"type,omitempty"`
+	Descript   string    `json:"descript,omitempty"`
+	Perstag    string    `json:"perstag,omitempty"`
+	Defval     string    `json:"defval,omitempty"`
+	IsRequired string    `json:"isrequired,omitempty"`
+	Visible    string    `json:"visible,omitempty"`
+	OrderNum   string    `json:"ordernum,omitempty"`
+	ShowInList string    `json:"show_in_list,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate     string      `json:"cdate,omitempty"`
+	Udate     string      `json:"udate,omitempty"`
+	Options   []string    `json:"options,omitempty"`
+	Relations []string    `json:"relations,omitempty"`
+	Links     *FieldLinks `json:"links,omitempty"`
+	ID        string      `json:"id,omitempty"`
+}
+
+// FieldLinks are the related resource URLs returned with a field.
+type FieldLinks struct {
+	Options   string `json:"options"`
+	Relations string `json:"relations"`
+}
+
+// FieldOption is one of the values a dropdown, multiselect, radio or checkbox field can take.
+type FieldOption struct {
+	Field     string `json:"field"`
+	Label     string `json:"label"`
+	Value     string `json:"value"`
+	OrderID   string `json:"orderid,omitempty"`
+	IsDefault string `json:"isdefault,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate string `json:"cdate,omitempty"`
+	Udate string `json:"udate,omitempty"`
+	ID    string `json:"id,omitempty"`
+}
+
+// FieldRel attaches a field to a list. A RelID of "0" makes the field available to all lists.
+type FieldRel struct {
+	Field string `json:"field"`
+	RelID string `json:"relid"`
+
+	// Read-only fields returned by Active Campaign.
+	Dorder string `json:"dorder,omitempty"`
+	Cdate  string `json:"cdate,omitempty"`
+	ID     string `json:"id,omitempty"`
+}
+
+// CreateFieldRequest is the request body used for creating a field.
+type CreateFieldRequest struct {
+	Field *Field `json:"field"`
+}
+
+// UpdateFieldRequest is the request body used for updating a field.
+type UpdateFieldRequest struct {
+	Field *Field `json:"field"`
+}
+
+// FieldResponse is the response body returned from creating, retrieving or updating a field.
+type FieldResponse struct {
+	Field        *Field         `json:"field"`
+	FieldOptions []*FieldOption `json:"fieldOptions,omitempty"`
+	FieldRels    []*FieldRel    `json:"fieldRels,omitempty"`
+}
+
+// ListFieldsResponse is the response body returned from listing fields.
+type ListFieldsResponse struct {
+	Fields       []*Field       `json:"fields"`
+	FieldOptions []*FieldOption `json:"fieldOptions"`
+	FieldRels    []*FieldRel    `json:"fieldRels"`
+	Meta         *Meta          `json:"meta"`
+}
+
+// Create a field.
+func (s *FieldsService) Create(ctx context.Context, field *CreateFieldRequest) (*FieldResponse, *Response, error) {
+	u := "fields"
+	req, err := s.client.NewRequest(http.MethodPost, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a field.
+func (s *FieldsService) Retrieve(ctx context.Context, id string) (*FieldResponse, *Response, error) {
+	u := "fields/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a field.
+func (s *FieldsService) Update(ctx context.Context, id string, field *UpdateFieldRequest) (*FieldResponse, *Response, error) {
+	u := "fields/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a field.
+func (s *FieldsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "fields/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List fields, one page at a time. Use ListAllPages to walk every page.
+func (s *FieldsService) List(ctx context.Context, opts *ListOptions) (*ListFieldsResponse, *Response, error) {
+	u, err := addOptions("fields", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListFieldsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CreateFieldOptionsRequest is the request body used for creating field options.
+type CreateFieldOptionsRequest struct {
+	FieldOptions []*FieldOption `json:"fieldOptions"`
+}
+
+// FieldOptionsResponse is the response body returned from creating field options.
+type FieldOptionsResponse struct {
+	FieldOptions []*FieldOption `json:"fieldOptions"`
+}
+
+// CreateOptions creates the options of dropdown, multiselect, radio and checkbox fields in bulk.
+func (s *FieldsService) CreateOptions(ctx context.Context, options *CreateFieldOptionsRequest) (*FieldOptionsResponse, *Response, error) {
+	u := "fieldOptions/bulk"
+	req, err := s.client.NewRequest(http.MethodPost, u, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldOptionsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// CreateFieldRelRequest is the request body used for attaching a field to a list.
+type CreateFieldRelRequest struct {
+	FieldRel *FieldRel `json:"fieldRel"`
+}
+
+// FieldRelResponse is the response body returned from attaching a field to a list.
+type FieldRelResponse struct {
+	FieldRel *FieldRel `json:"fieldRel"`
+}
+
+// AddToList attaches a field to a list, so that it is shown for contacts on that list.
+func (s *FieldsService) AddToList(ctx context.Context, rel *CreateFieldRelRequest) (*FieldRelResponse, *Response, error) {
+	u := "fieldRels"
+	req, err := s.client.NewRequest(http.MethodPost, u, rel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &FieldRelResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
@@ -0,0 +1,284 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFieldsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateFieldRequest{
+		&Field{
+			Title:   "Company Size",
+			Type:    FieldTypeDropdown,
+			Perstag: "COMPANY_SIZE",
+			Visible: "1",
+		},
+	}
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateFieldRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"field": {
+					"title": "Company Size",
+					"type": "dropdown",
+					"perstag": "COMPANY_SIZE",
+					"visible": "1",
+					"cdate": "2020-06-08T19:49:42-05:00",
+					"udate": "2020-06-08T19:49:42-05:00",
+					"links": {
+						"options": "https://your_base_url.api-us1.com/api/3/fields/1/options",
+						"relations": "https://your_base_url.api-us1.com/api/3/fields/1/relations"
+					},
+					"id": "1"
+				}
+			}`)
+	})
+
+	field, _, err := c.Fields.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Fields.Create returned error: %v", err)
+	}
+
+	want := &FieldResponse{
+		Field: &Field{
+			Title:   "Company Size",
+			Type:    FieldTypeDropdown,
+			Perstag: "COMPANY_SIZE",
+			Visible: "1",
+			Cdate:   "2020-06-08T19:49:42-05:00",
+			Udate:   "2020-06-08T19:49:42-05:00",
+			Links: &FieldLinks{
+				Options:   "https://your_base_url.api-us1.com/api/3/fields/1/options",
+				Relations: "https://your_base_url.api-us1.com/api/3/fields/1/relations",
+			},
+			ID: "1",
+		},
+	}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Fields.Create returned %+v, want %+v", field, want)
+	}
+}
+
+func TestFieldsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"field": {"title": "Size", "type": "radio", "options": ["2"], "relations": ["3"], "id": "1"},
+				"fieldOptions": [{"field": "1", "label": "Small", "value": "small", "id": "2"}],
+				"fieldRels": [{"field": "1", "relid": "0", "id": "3"}]
+			}`)
+	})
+
+	field, _, err := c.Fields.Retrieve(ctx, "1")
+	if err != nil {
+		t.Fatalf("Fields.Retrieve returned error: %v", err)
+	}
+
+	want := &FieldResponse{
+		Field:        &Field{Title: "Size", Type: FieldTypeRadio, Options: []string{"2"}, Relations: []string{"3"}, ID: "1"},
+		FieldOptions: []*FieldOption{{Field: "1", Label: "Small", Value: "small", ID: "2"}},
+		FieldRels:    []*FieldRel{{Field: "1", RelID: "0", ID: "3"}},
+	}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Fields.Retrieve returned %+v, want %+v", field, want)
+	}
+}
+
+func TestFieldsService_Retrieve_NotFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := c.Fields.Retrieve(ctx, "1")
+	if !IsNotFound(err) {
+		t.Errorf("Fields.Retrieve returned %v, want a not found error", err)
+	}
+}
+
+func TestFieldsService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateFieldRequest{&Field{Title: "Team Size"}}
+
+	mux.HandleFunc("/api/3/fields/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateFieldRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"field": {"title": "Team Size", "type": "text", "id": "1"}}`)
+	})
+
+	field, _, err := c.Fields.Update(ctx, "1", input)
+	if err != nil {
+		t.Fatalf("Fields.Update returned error: %v", err)
+	}
+
+	want := &FieldResponse{Field: &Field{Title: "Team Size", Type: FieldTypeText, ID: "1"}}
+	if !reflect.DeepEqual(field, want) {
+		t.Errorf("Fields.Update returned %+v, want %+v", field, want)
+	}
+}
+
+func TestFieldsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Fields.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Fields.Delete returned error: %v", err)
+	}
+}
+
+func TestFieldsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"limit": "100"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"fieldOptions": [],
+				"fieldRels": [{"field": "1", "relid": "0", "id": "3"}],
+				"fields": [{"title": "Size", "type": "text", "perstag": "SIZE", "id": "1"}],
+				"meta": {"total": "1"}
+			}`)
+	})
+
+	fields, _, err := c.Fields.List(ctx, &ListOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("Fields.List returned error: %v", err)
+	}
+
+	want := &ListFieldsResponse{
+		Fields:       []*Field{{Title: "Size", Type: FieldTypeText, Perstag: "SIZE", ID: "1"}},
+		FieldOptions: []*FieldOption{},
+		FieldRels:    []*FieldRel{{Field: "1", RelID: "0", ID: "3"}},
+		Meta:         &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Fields.List returned %+v, want %+v", fields, want)
+	}
+}
+
+func TestFieldsService_CreateOptions(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateFieldOptionsRequest{
+		FieldOptions: []*FieldOption{
+			{Field: "1", Label: "Small", Value: "small"},
+			{Field: "1", Label: "Large", Value: "large", IsDefault: "1"},
+		},
+	}
+
+	mux.HandleFunc("/api/3/fieldOptions/bulk", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateFieldOptionsRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"fieldOptions": [
+					{"field": "1", "label": "Small", "value": "small", "isdefault": "0", "id": "2"},
+					{"field": "1", "label": "Large", "value": "large", "isdefault": "1", "id": "3"}
+				]
+			}`)
+	})
+
+	options, _, err := c.Fields.CreateOptions(ctx, input)
+	if err != nil {
+		t.Fatalf("Fields.CreateOptions returned error: %v", err)
+	}
+
+	want := &FieldOptionsResponse{
+		FieldOptions: []*FieldOption{
+			{Field: "1", Label: "Small", Value: "small", IsDefault: "0", ID: "2"},
+			{Field: "1", Label: "Large", Value: "large", IsDefault: "1", ID: "3"},
+		},
+	}
+	if !reflect.DeepEqual(options, want) {
+		t.Errorf("Fields.CreateOptions returned %+v, want %+v", options, want)
+	}
+}
+
+func TestFieldsService_AddToList(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateFieldRelRequest{&FieldRel{Field: "1", RelID: "2"}}
+
+	mux.HandleFunc("/api/3/fieldRels", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateFieldRelRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"fieldRel": {"field": "1", "relid": "2", "dorder": "0", "id": "4"}}`)
+	})
+
+	rel, _, err := c.Fields.AddToList(ctx, input)
+	if err != nil {
+		t.Fatalf("Fields.AddToList returned error: %v", err)
+	}
+
+	want := &FieldRelResponse{&FieldRel{Field: "1", RelID: "2", Dorder: "0", ID: "4"}}
+	if !reflect.DeepEqual(rel, want) {
+		t.Errorf("Fields.AddToList returned %+v, want %+v", rel, want)
+	}
+}
+
+func TestFieldType_HasOptions(t *testing.T) {
+	for _, ft := range []FieldType{FieldTypeDropdown, FieldTypeMultiselect, FieldTypeRadio, FieldTypeCheckbox} {
+		if !ft.HasOptions() {
+			t.Errorf("%s.HasOptions() = false, want true", ft)
+		}
+	}
+	for _, ft := range []FieldType{FieldTypeText, FieldTypeDate, FieldTypeCurrency, FieldTypeNull} {
+		if ft.HasOptions() {
+			t.Errorf("%s.HasOptions() = true, want false", ft)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListsService handles communication with list related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#lists
+type ListsService service
+
+// List is a mailing list that contacts can be subscribed to.
+type List struct {
+	Name                 string `json:"name,omitempty"`
+	StringID             string `json:"stringid,omitempty"`
+	SenderURL            string `json:"sender_url,omitempty"`
+	SenderReminder       string `json:"sender_reminder,omitempty"`
+	SendLastBroadcast    string `json:"send_last_broadcast,omitempty"`
+	Carboncopy           string `json:"carboncopy,omitempty"`
+	SubscriptionNotify   string `json:"subscription_notify,omitempty"`
+	UnsubscriptionNotify string `json:"unsubscription_notify,omitempty"`
+	User                 string `json:"user,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate string `json:"cdate,omitempty"`
+	Udate string `json:"udate,omitempty"`
+	ID    string `json:"id,omitempty"`
+}
+
+// CreateListRequest is the request body used for creating a list.
+type CreateListRequest struct {
+	List *List `json:"list"`
+}
+
+// ListResponse is the response body returned from creating or retrieving a list.
+type ListResponse struct {
+	List *List `json:"list"`
+}
+
+// ListListsOptions specifies the optional parameters to ListsService.List.
+type ListListsOptions struct {
+	ListOptions
+
+	// Name filters lists by name.
+	Name string `url:"filters[name],omitempty"`
+}
+
+// ListListsResponse is the response body returned from listing lists.
+type ListListsResponse struct {
+	Lists []*List `json:"lists"`
+	Meta  *Meta   `json:"meta"`
+}
+
+// Create a list.
+func (s *ListsService) Create(ctx context.Context, list *CreateListRequest) (*ListResponse, *Response, error) {
+	u := "lists"
+	req, err := s.client.NewRequest(http.MethodPost, u, list)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a list.
+func (s *ListsService) Retrieve(ctx context.Context, id string) (*ListResponse, *Response, error) {
+	u := "lists/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// List lists, one page at a time. Use ListAllPages to walk every page.
+func (s *ListsService) List(ctx context.Context, opts *ListListsOptions) (*ListListsResponse, *Response, error) {
+	u, err := addOptions("lists", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListListsResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// FindByStringID walks every list and returns the one with the given stringid, or nil if there is none.
+func (s *ListsService) FindByStringID(ctx context.Context, stringID string) (*List, error) {
+	var found *List
+	opts := &ListListsOptions{ListOptions: ListOptions{Limit: 100}}
+	err := ListAllPages(ctx, &opts.ListOptions, func(*ListOptions) (*Response, error) {
+		lists, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, l := range lists.Lists {
+			if l.StringID == stringID {
+				found = l
+				return nil, nil
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// Delete a list.
+func (s *ListsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "lists/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// ListGroup grants a user group permission to a list.
+type ListGroup struct {
+	ListID  string `json:"listid"`
+	GroupID string `json:"groupid"`
+	ID      string `json:"id,omitempty"`
+}
+
+// CreateListGroupRequest is the request body used for creating a list group permission.
+type CreateListGroupRequest struct {
+	ListGroup *ListGroup `json:"listGroup"`
+}
+
+// ListGroupResponse is the response body returned from creating a list group permission.
+type ListGroupResponse struct {
+	ListGroup *ListGroup `json:"listGroup"`
+}
+
+// CreateGroupPermission grants a user group permission to a list.
+func (s *ListsService) CreateGroupPermission(ctx context.Context, listGroup *CreateListGroupRequest) (*ListGroupResponse, *Response, error) {
+	u := "listGroups"
+	req, err := s.client.NewRequest(http.MethodPost, u, listGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListGroupResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
@@ -0,0 +1,218 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListsService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateListRequest{
+		&List{
+			Name:           "Customer 1",
+			StringID:       "customer-1",
+			SenderURL:      "https://example.com",
+			SenderReminder: "You signed up on example.com",
+		},
+	}
+
+	mux.HandleFunc("/api/3/lists", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateListRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"list": {
+					"name": "Customer 1",
+					"stringid": "customer-1",
+					"sender_url": "https://example.com",
+					"sender_reminder": "You signed up on example.com",
+					"cdate": "2020-06-08T19:49:42-05:00",
+					"udate": "2020-06-08T19:49:42-05:00",
+					"id": "1"
+				}
+			}`)
+	})
+
+	list, _, err := c.Lists.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Lists.Create returned error: %v", err)
+	}
+
+	want := &ListResponse{
+		&List{
+			Name:           "Customer 1",
+			StringID:       "customer-1",
+			SenderURL:      "https://example.com",
+			SenderReminder: "You signed up on example.com",
+			Cdate:          "2020-06-08T19:49:42-05:00",
+			Udate:          "2020-06-08T19:49:42-05:00",
+			ID:             "1",
+		},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("Lists.Create returned %+v, want %+v", list, want)
+	}
+}
+
+func TestListsService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/lists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"list": {"name": "n", "stringid": "s", "id": "1"}}`)
+	})
+
+	list, _, err := c.Lists.Retrieve(ctx, "1")
+	if err != nil {
+		t.Fatalf("Lists.Retrieve returned error: %v", err)
+	}
+
+	want := &ListResponse{&List{Name: "n", StringID: "s", ID: "1"}}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("Lists.Retrieve returned %+v, want %+v", list, want)
+	}
+}
+
+func TestListsService_Retrieve_NotFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/lists/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, resp, err := c.Lists.Retrieve(ctx, "1")
+	if !IsNotFound(err) {
+		t.Errorf("Lists.Retrieve returned %v, want a not found error", err)
+	}
+	if resp == nil {
+		t.Errorf("Expected response. Response is nil")
+	}
+}
+
+func TestListsService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[name]": "Customer", "limit": "10"})
+		_, _ = fmt.Fprint(w, `{"lists": [{"name": "Customer 1", "id": "1"}], "meta": {"total": "1"}}`)
+	})
+
+	lists, _, err := c.Lists.List(ctx, &ListListsOptions{ListOptions: ListOptions{Limit: 10}, Name: "Customer"})
+	if err != nil {
+		t.Fatalf("Lists.List returned error: %v", err)
+	}
+
+	want := &ListListsResponse{
+		Lists: []*List{{Name: "Customer 1", ID: "1"}},
+		Meta:  &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(lists, want) {
+		t.Errorf("Lists.List returned %+v, want %+v", lists, want)
+	}
+}
+
+func TestListsService_FindByStringID(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	pages := 0
+	mux.HandleFunc("/api/3/lists", func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = fmt.Fprint(w, `{"lists": [{"stringid": "a", "id": "1"}], "meta": {"total": "250"}}`)
+		case "100":
+			_, _ = fmt.Fprint(w, `{"lists": [{"stringid": "b", "id": "2"}], "meta": {"total": "250"}}`)
+		default:
+			t.Errorf("Lists.FindByStringID requested offset %q after finding the list", r.URL.Query().Get("offset"))
+		}
+	})
+
+	list, err := c.Lists.FindByStringID(ctx, "b")
+	if err != nil {
+		t.Fatalf("Lists.FindByStringID returned error: %v", err)
+	}
+	if want := (&List{StringID: "b", ID: "2"}); !reflect.DeepEqual(list, want) {
+		t.Errorf("Lists.FindByStringID returned %+v, want %+v", list, want)
+	}
+	if pages != 2 {
+		t.Errorf("Lists.FindByStringID fetched %d pages, want 2", pages)
+	}
+}
+
+func TestListsService_FindByStringID_notFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/lists", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"lists": [{"stringid": "a", "id": "1"}], "meta": {"total": "1"}}`)
+	})
+
+	list, err := c.Lists.FindByStringID(ctx, "b")
+	if err != nil {
+		t.Fatalf("Lists.FindByStringID returned error: %v", err)
+	}
+	if list != nil {
+		t.Errorf("Lists.FindByStringID returned %+v, want nil", list)
+	}
+}
+
+func TestListsService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/lists/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Lists.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Lists.Delete returned error: %v", err)
+	}
+}
+
+func TestListsService_CreateGroupPermission(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateListGroupRequest{&ListGroup{ListID: "1", GroupID: "3"}}
+
+	mux.HandleFunc("/api/3/listGroups", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateListGroupRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"listGroup": {"listid": "1", "groupid": "3", "id": "5"}}`)
+	})
+
+	listGroup, _, err := c.Lists.CreateGroupPermission(ctx, input)
+	if err != nil {
+		t.Fatalf("Lists.CreateGroupPermission returned error: %v", err)
+	}
+
+	want := &ListGroupResponse{&ListGroup{ListID: "1", GroupID: "3", ID: "5"}}
+	if !reflect.DeepEqual(listGroup, want) {
+		t.Errorf("Lists.CreateGroupPermission returned %+v, want %+v", listGroup, want)
+	}
+}
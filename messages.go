@@ -0,0 +1,154 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+)
+
+// MessagesService handles communication with email message related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#messages
+type MessagesService service
+
+// Message is the content of an email sent by a campaign.
+type Message struct {
+	Name          string `json:"name,omitempty"`
+	FromName      string `json:"fromname,omitempty"`
+	FromEmail     string `json:"fromemail,omitempty"`
+	ReplyTo       string `json:"reply2,omitempty"`
+	Subject       string `json:"subject,omitempty"`
+	PreheaderText string `json:"preheader_text,omitempty"`
+	// Format is "mime" to send both the HTML and text bodies, or "html" or "text" to send only one.
+	Format string `json:"format,omitempty"`
+	HTML   string `json:"html,omitempty"`
+	Text   string `json:"text,omitempty"`
+	// HTMLFetch and TextFetch are URLs the bodies are fetched from when sending, instead of HTML and Text.
+	HTMLFetch string `json:"htmlfetch,omitempty"`
+	TextFetch string `json:"textfetch,omitempty"`
+	Charset   string `json:"charset,omitempty"`
+	Encoding  string `json:"encoding,omitempty"`
+	Priority  string `json:"priority,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	UserID   string        `json:"userid,omitempty"`
+	Cdate    string        `json:"cdate,omitempty"`
+	Mdate    string        `json:"mdate,omitempty"`
+	Hidden   string        `json:"hidden,omitempty"`
+	SourceID string        `json:"sourceid,omitempty"`
+	Links    *MessageLinks `json:"links,omitempty"`
+	ID       string        `json:"id,omitempty"`
+}
+
+// MessageLinks are the related resource URLs returned with a message.
+type MessageLinks struct {
+	User string `json:"user"`
+}
+
+// CreateMessageRequest is the request body used for creating a message.
+type CreateMessageRequest struct {
+	Message *Message `json:"message"`
+}
+
+// UpdateMessageRequest is the request body used for updating a message.
+type UpdateMessageRequest struct {
+	Message *Message `json:"message"`
+}
+
+// MessageResponse is the response body returned from creating, retrieving or updating a message.
+type MessageResponse struct {
+	Message *Message `json:"message"`
+}
+
+// ListMessagesResponse is the response body returned from listing messages.
+type ListMessagesResponse struct {
+	Messages []*Message `json:"messages"`
+	Meta     *Meta      `json:"meta"`
+}
+
+// Create a message.
+func (s *MessagesService) Create(ctx context.Context, message *CreateMessageRequest) (*MessageResponse, *Response, error) {
+	u := "messages"
+	req, err := s.client.NewRequest(http.MethodPost, u, message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &MessageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a message.
+func (s *MessagesService) Retrieve(ctx context.Context, id string) (*MessageResponse, *Response, error) {
+	u := "messages/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &MessageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a message.
+func (s *MessagesService) Update(ctx context.Context, id string, message *UpdateMessageRequest) (*MessageResponse, *Response, error) {
+	u := "messages/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &MessageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a message.
+func (s *MessagesService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "messages/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List messages, one page at a time. Use ListAllPages to walk every page.
+func (s *MessagesService) List(ctx context.Context, opts *ListOptions) (*ListMessagesResponse, *Response, error) {
+	u, err := addOptions("messages", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListMessagesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
@@ -0,0 +1,184 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMessagesService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateMessageRequest{
+		&Message{
+			FromName:      "Example",
+			FromEmail:     "news@example.com",
+			ReplyTo:       "support@example.com",
+			Subject:       "June Newsletter",
+			PreheaderText: "What's new this month",
+			Format:        "mime",
+			HTML:          "<html><body><h1>June Newsletter</h1></body></html>",
+			Text:          "June Newsletter",
+		},
+	}
+
+	mux.HandleFunc("/api/3/messages", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateMessageRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"message": {
+					"userid": "1",
+					"cdate": "2020-06-24T12:00:00-05:00",
+					"mdate": "2020-06-24T12:00:00-05:00",
+					"fromname": "Example",
+					"fromemail": "news@example.com",
+					"reply2": "support@example.com",
+					"priority": "3",
+					"charset": "utf-8",
+					"encoding": "quoted-printable",
+					"format": "mime",
+					"subject": "June Newsletter",
+					"preheader_text": "What's new this month",
+					"text": "June Newsletter",
+					"html": "<html><body><h1>June Newsletter</h1></body></html>",
+					"hidden": "0",
+					"links": {
+						"user": "https://your_base_url.api-us1.com/api/3/messages/30/user"
+					},
+					"id": "30"
+				}
+			}`)
+	})
+
+	message, _, err := c.Messages.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Messages.Create returned error: %v", err)
+	}
+
+	want := &MessageResponse{
+		Message: &Message{
+			FromName:      "Example",
+			FromEmail:     "news@example.com",
+			ReplyTo:       "support@example.com",
+			Subject:       "June Newsletter",
+			PreheaderText: "What's new this month",
+			Format:        "mime",
+			HTML:          "<html><body><h1>June Newsletter</h1></body></html>",
+			Text:          "June Newsletter",
+			Charset:       "utf-8",
+			Encoding:      "quoted-printable",
+			Priority:      "3",
+			UserID:        "1",
+			Cdate:         "2020-06-24T12:00:00-05:00",
+			Mdate:         "2020-06-24T12:00:00-05:00",
+			Hidden:        "0",
+			Links:         &MessageLinks{User: "https://your_base_url.api-us1.com/api/3/messages/30/user"},
+			ID:            "30",
+		},
+	}
+	if !reflect.DeepEqual(message, want) {
+		t.Errorf("Messages.Create returned %+v, want %+v", message, want)
+	}
+}
+
+func TestMessagesService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/messages/30", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"message": {"subject": "June Newsletter", "html": "<p>Hi</p>", "id": "30"}}`)
+	})
+
+	message, _, err := c.Messages.Retrieve(ctx, "30")
+	if err != nil {
+		t.Fatalf("Messages.Retrieve returned error: %v", err)
+	}
+
+	want := &MessageResponse{Message: &Message{Subject: "June Newsletter", HTML: "<p>Hi</p>", ID: "30"}}
+	if !reflect.DeepEqual(message, want) {
+		t.Errorf("Messages.Retrieve returned %+v, want %+v", message, want)
+	}
+}
+
+func TestMessagesService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateMessageRequest{&Message{HTML: "<p>Hello again</p>"}}
+
+	mux.HandleFunc("/api/3/messages/30", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateMessageRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"message": {"subject": "June Newsletter", "html": "<p>Hello again</p>", "id": "30"}}`)
+	})
+
+	message, _, err := c.Messages.Update(ctx, "30", input)
+	if err != nil {
+		t.Fatalf("Messages.Update returned error: %v", err)
+	}
+
+	want := &MessageResponse{Message: &Message{Subject: "June Newsletter", HTML: "<p>Hello again</p>", ID: "30"}}
+	if !reflect.DeepEqual(message, want) {
+		t.Errorf("Messages.Update returned %+v, want %+v", message, want)
+	}
+}
+
+func TestMessagesService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/messages/30", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Messages.Delete(ctx, "30")
+	if err != nil {
+		t.Errorf("Messages.Delete returned error: %v", err)
+	}
+}
+
+func TestMessagesService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"limit": "1", "offset": "1"})
+		_, _ = fmt.Fprint(w, `{"messages": [{"subject": "July Newsletter", "id": "31"}], "meta": {"total": "3"}}`)
+	})
+
+	messages, resp, err := c.Messages.List(ctx, &ListOptions{Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatalf("Messages.List returned error: %v", err)
+	}
+
+	want := &ListMessagesResponse{
+		Messages: []*Message{{Subject: "July Newsletter", ID: "31"}},
+		Meta:     &Meta{Total: "3"},
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("Messages.List returned %+v, want %+v", messages, want)
+	}
+	if resp.Total != 3 || resp.NextOffset != 2 {
+		t.Errorf("Messages.List returned Total %d and NextOffset %d, want 3 and 2", resp.Total, resp.NextOffset)
+	}
+}
@@ -0,0 +1,187 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// PipelinesService handles communication with deal pipeline (deal group) related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#pipelines
+type PipelinesService service
+
+// Pipeline is a sequence of stages that deals move through. Active Campaign calls pipelines deal groups.
+type Pipeline struct {
+	Title      string `json:"title,omitempty"`
+	Currency   string `json:"currency,omitempty"`
+	AllGroups  string `json:"allgroups,omitempty"`
+	AllUsers   string `json:"allusers,omitempty"`
+	AutoAssign string `json:"autoassign,omitempty"`
+
+	// Users and Groups grant access to the pipeline when AllUsers or AllGroups is "0".
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate  string         `json:"cdate,omitempty"`
+	Udate  string         `json:"udate,omitempty"`
+	Stages []string       `json:"stages,omitempty"`
+	Links  *PipelineLinks `json:"links,omitempty"`
+	ID     string         `json:"id,omitempty"`
+}
+
+// PipelineLinks are the related resource URLs returned with a pipeline.
+type PipelineLinks struct {
+	Stages string `json:"stages"`
+}
+
+// CreatePipelineRequest is the request body used for creating a pipeline.
+type CreatePipelineRequest struct {
+	Pipeline *Pipeline `json:"dealGroup"`
+}
+
+// UpdatePipelineRequest is the request body used for updating a pipeline.
+type UpdatePipelineRequest struct {
+	Pipeline *Pipeline `json:"dealGroup"`
+}
+
+// PipelineResponse is the response body returned from creating, retrieving or updating a pipeline.
+// Creating a pipeline also returns the stages Active Campaign adds to it by default.
+type PipelineResponse struct {
+	Pipeline *Pipeline `json:"dealGroup"`
+	Stages   []*Stage  `json:"dealStages,omitempty"`
+}
+
+// ListPipelinesOptions specifies the optional parameters to PipelinesService.List.
+type ListPipelinesOptions struct {
+	ListOptions
+
+	// Title filters pipelines whose title contains the given value.
+	Title string `url:"filters[title],omitempty"`
+	// HaveStages filters pipelines that have at least one stage.
+	HaveStages bool `url:"filters[have_stages],omitempty"`
+
+	// Orders sort the results. Each accepts "ASC" or "DESC".
+	OrderByTitle   string `url:"orders[title],omitempty"`
+	OrderByPopular string `url:"orders[popular],omitempty"`
+}
+
+// ListPipelinesResponse is the response body returned from listing pipelines.
+type ListPipelinesResponse struct {
+	Pipelines []*Pipeline `json:"dealGroups"`
+	Stages    []*Stage    `json:"dealStages,omitempty"`
+	Meta      *Meta       `json:"meta"`
+}
+
+// Create a pipeline.
+func (s *PipelinesService) Create(ctx context.Context, pipeline *CreatePipelineRequest) (*PipelineResponse, *Response, error) {
+	u := "dealGroups"
+	req, err := s.client.NewRequest(http.MethodPost, u, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &PipelineResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a pipeline.
+func (s *PipelinesService) Retrieve(ctx context.Context, id string) (*PipelineResponse, *Response, error) {
+	u := "dealGroups/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &PipelineResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a pipeline.
+func (s *PipelinesService) Update(ctx context.Context, id string, pipeline *UpdatePipelineRequest) (*PipelineResponse, *Response, error) {
+	u := "dealGroups/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &PipelineResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a pipeline, along with its stages and deals.
+func (s *PipelinesService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "dealGroups/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List pipelines, one page at a time. Use ListAllPages to walk every page.
+func (s *PipelinesService) List(ctx context.Context, opts *ListPipelinesOptions) (*ListPipelinesResponse, *Response, error) {
+	u, err := addOptions("dealGroups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListPipelinesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// FindByTitle returns the pipeline whose title matches the given one case-insensitively, or nil if there is none.
+func (s *PipelinesService) FindByTitle(ctx context.Context, title string) (*Pipeline, error) {
+	var found *Pipeline
+	opts := &ListPipelinesOptions{ListOptions: ListOptions{Limit: 100}, Title: title}
+	err := ListAllPages(ctx, &opts.ListOptions, func(*ListOptions) (*Response, error) {
+		pipelines, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, p := range pipelines.Pipelines {
+			if strings.EqualFold(p.Title, title) {
+				found = p
+				return nil, nil
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
@@ -0,0 +1,218 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPipelinesService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreatePipelineRequest{
+		&Pipeline{
+			Title:     "Enterprise",
+			Currency:  "usd",
+			AllGroups: "1",
+			AllUsers:  "1",
+		},
+	}
+
+	mux.HandleFunc("/api/3/dealGroups", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreatePipelineRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealStages": [
+					{"title": "To Contact", "group": "2", "order": "1", "id": "4"},
+					{"title": "In Contact", "group": "2", "order": "2", "id": "5"}
+				],
+				"dealGroup": {
+					"title": "Enterprise",
+					"currency": "usd",
+					"allgroups": "1",
+					"allusers": "1",
+					"autoassign": "1",
+					"cdate": "2020-06-24T12:00:00-05:00",
+					"udate": "2020-06-24T12:00:00-05:00",
+					"stages": ["4", "5"],
+					"links": {
+						"stages": "https://your_base_url.api-us1.com/api/3/dealGroups/2/stages"
+					},
+					"id": "2"
+				}
+			}`)
+	})
+
+	pipeline, _, err := c.Pipelines.Create(ctx, input)
+	if err != nil {
+		t.Fatalf("Pipelines.Create returned error: %v", err)
+	}
+
+	want := &PipelineResponse{
+		Pipeline: &Pipeline{
+			Title:      "Enterprise",
+			Currency:   "usd",
+			AllGroups:  "1",
+			AllUsers:   "1",
+			AutoAssign: "1",
+			Cdate:      "2020-06-24T12:00:00-05:00",
+			Udate:      "2020-06-24T12:00:00-05:00",
+			Stages:     []string{"4", "5"},
+			Links:      &PipelineLinks{Stages: "https://your_base_url.api-us1.com/api/3/dealGroups/2/stages"},
+			ID:         "2",
+		},
+		Stages: []*Stage{
+			{Title: "To Contact", Group: "2", Order: "1", ID: "4"},
+			{Title: "In Contact", Group: "2", Order: "2", ID: "5"},
+		},
+	}
+	if !reflect.DeepEqual(pipeline, want) {
+		t.Errorf("Pipelines.Create returned %+v, want %+v", pipeline, want)
+	}
+}
+
+func TestPipelinesService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealGroups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"dealGroup": {"title": "Enterprise", "currency": "usd", "id": "2"}}`)
+	})
+
+	pipeline, _, err := c.Pipelines.Retrieve(ctx, "2")
+	if err != nil {
+		t.Fatalf("Pipelines.Retrieve returned error: %v", err)
+	}
+
+	want := &PipelineResponse{Pipeline: &Pipeline{Title: "Enterprise", Currency: "usd", ID: "2"}}
+	if !reflect.DeepEqual(pipeline, want) {
+		t.Errorf("Pipelines.Retrieve returned %+v, want %+v", pipeline, want)
+	}
+}
+
+func TestPipelinesService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdatePipelineRequest{&Pipeline{Title: "Enterprise (EU)", Currency: "eur"}}
+
+	mux.HandleFunc("/api/3/dealGroups/2", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdatePipelineRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"dealGroup": {"title": "Enterprise (EU)", "currency": "eur", "id": "2"}}`)
+	})
+
+	pipeline, _, err := c.Pipelines.Update(ctx, "2", input)
+	if err != nil {
+		t.Fatalf("Pipelines.Update returned error: %v", err)
+	}
+
+	want := &PipelineResponse{Pipeline: &Pipeline{Title: "Enterprise (EU)", Currency: "eur", ID: "2"}}
+	if !reflect.DeepEqual(pipeline, want) {
+		t.Errorf("Pipelines.Update returned %+v, want %+v", pipeline, want)
+	}
+}
+
+func TestPipelinesService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealGroups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Pipelines.Delete(ctx, "2")
+	if err != nil {
+		t.Errorf("Pipelines.Delete returned error: %v", err)
+	}
+}
+
+func TestPipelinesService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealGroups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filters[title]":       "Enter",
+			"filters[have_stages]": "1",
+			"orders[title]":        "ASC",
+		})
+		_, _ = fmt.Fprint(w, `{"dealGroups": [{"title": "Enterprise", "stages": ["4"], "id": "2"}], "meta": {"total": "1"}}`)
+	})
+
+	pipelines, _, err := c.Pipelines.List(ctx, &ListPipelinesOptions{Title: "Enter", HaveStages: true, OrderByTitle: "ASC"})
+	if err != nil {
+		t.Fatalf("Pipelines.List returned error: %v", err)
+	}
+
+	want := &ListPipelinesResponse{
+		Pipelines: []*Pipeline{{Title: "Enterprise", Stages: []string{"4"}, ID: "2"}},
+		Meta:      &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(pipelines, want) {
+		t.Errorf("Pipelines.List returned %+v, want %+v", pipelines, want)
+	}
+}
+
+func TestPipelinesService_FindByTitle(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealGroups", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"filters[title]": "enterprise", "limit": "100"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealGroups": [
+					{"title": "Enterprise (EU)", "id": "3"},
+					{"title": "Enterprise", "id": "2"}
+				],
+				"meta": {"total": "2"}
+			}`)
+	})
+
+	pipeline, err := c.Pipelines.FindByTitle(ctx, "enterprise")
+	if err != nil {
+		t.Fatalf("Pipelines.FindByTitle returned error: %v", err)
+	}
+	if pipeline == nil || pipeline.ID != "2" {
+		t.Errorf("Pipelines.FindByTitle returned %+v, want pipeline 2", pipeline)
+	}
+}
+
+func TestPipelinesService_FindByTitle_notFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealGroups", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"dealGroups": [{"title": "Enterprise (EU)", "id": "3"}], "meta": {"total": "1"}}`)
+	})
+
+	pipeline, err := c.Pipelines.FindByTitle(ctx, "Enterprise")
+	if err != nil {
+		t.Fatalf("Pipelines.FindByTitle returned error: %v", err)
+	}
+	if pipeline != nil {
+		t.Errorf("Pipelines.FindByTitle returned %+v, want nil", pipeline)
+	}
+}
@@ -0,0 +1,89 @@
+package active_campaign
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the client-side request budget used when ClientOpts.RequestsPerSecond is zero.
+// It matches Active Campaign's documented limit of 5 requests per second per account.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#rate-limits
+const DefaultRequestsPerSecond = 5
+
+// rateLimiter spaces requests evenly so that no more than one request is started per interval.
+// It is shared by every service on a Client.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time // earliest time the next request may start
+
+	waiting int32 // number of requests currently blocked in wait, accessed atomically
+}
+
+// newRateLimiter returns a rateLimiter allowing rps requests per second, or nil if rps is negative.
+func newRateLimiter(rps float64) *rateLimiter {
+	if rps < 0 {
+		return nil
+	}
+	if rps == 0 {
+		rps = DefaultRequestsPerSecond
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / rps)}
+}
+
+// wait blocks until the caller may send a request, or until ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	d := slot.Sub(now)
+	if d <= 0 {
+		return nil
+	}
+
+	atomic.AddInt32(&l.waiting, 1)
+	defer atomic.AddInt32(&l.waiting, -1)
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Give the slot back if nobody has reserved one after it.
+		l.mu.Lock()
+		if l.next.Equal(slot.Add(l.interval)) {
+			l.next = slot
+		}
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// queueDepth returns the number of requests currently waiting for a slot.
+func (l *rateLimiter) queueDepth() int {
+	if l == nil {
+		return 0
+	}
+	return int(atomic.LoadInt32(&l.waiting))
+}
+
+// RateLimitQueueDepth returns the number of requests currently blocked by the client-side rate limiter.
+// A value that stays above zero means the caller is producing requests faster than the configured budget.
+func (c *Client) RateLimitQueueDepth() int {
+	return c.limiter.queueDepth()
+}
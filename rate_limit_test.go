@@ -0,0 +1,120 @@
+package active_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewClient_defaultRateLimit(t *testing.T) {
+	c, err := NewClient(&ClientOpts{})
+	if err != nil {
+		t.Fatalf("NewClient returned unexpected error: %v", err)
+	}
+	if c.limiter == nil {
+		t.Fatalf("NewClient limiter is nil, want default rate limiter")
+	}
+	if got, want := c.limiter.interval, time.Second/DefaultRequestsPerSecond; got != want {
+		t.Errorf("NewClient limiter interval is %v, want %v", got, want)
+	}
+
+	c, err = NewClient(&ClientOpts{RequestsPerSecond: -1})
+	if err != nil {
+		t.Fatalf("NewClient returned unexpected error: %v", err)
+	}
+	if c.limiter != nil {
+		t.Errorf("NewClient limiter is %+v, want nil", c.limiter)
+	}
+}
+
+func TestClient_Do_rateLimitedAcrossServices(t *testing.T) {
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{RequestsPerSecond: 50})
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/api/3/contacts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, _ = c.Tags.ListAll(ctx, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, _ = c.Contacts.Create(ctx, &CreateContactRequest{&Contact{Email: "e"}})
+		}()
+	}
+	wg.Wait()
+
+	// 6 requests at 50 rps need at least 5 intervals of 20ms.
+	if elapsed, min := time.Since(start), 100*time.Millisecond; elapsed < min {
+		t.Errorf("6 requests took %v, want at least %v", elapsed, min)
+	}
+}
+
+func TestRateLimiter_queueDepth(t *testing.T) {
+	l := newRateLimiter(10)
+
+	// The first request goes through immediately and reserves the next slot.
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("wait returned unexpected error: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = l.wait(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for l.queueDepth() != 1 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := l.queueDepth(); got != 1 {
+		t.Errorf("queueDepth() = %d, want 1", got)
+	}
+
+	<-done
+	if got := l.queueDepth(); got != 0 {
+		t.Errorf("queueDepth() = %d, want 0", got)
+	}
+}
+
+func TestRateLimiter_waitHonorsContext(t *testing.T) {
+	l := newRateLimiter(0.1)
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("wait returned unexpected error: %v", err)
+	}
+
+	timeout, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait returned %v, want context.DeadlineExceeded", err)
+	}
+
+	// The abandoned slot is given back, so the next caller does not wait behind it.
+	if got, want := l.next.Sub(time.Now()), 10*time.Second; got > want {
+		t.Errorf("next slot is %v away, want at most %v", got, want)
+	}
+}
+
+func TestRateLimiter_nil(t *testing.T) {
+	var l *rateLimiter
+	if err := l.wait(ctx); err != nil {
+		t.Errorf("wait returned %v, want nil", err)
+	}
+	if got := l.queueDepth(); got != 0 {
+		t.Errorf("queueDepth() = %d, want 0", got)
+	}
+}
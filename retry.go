@@ -0,0 +1,170 @@
+package active_campaign
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultBaseBackoff = 500 * time.Millisecond
+	defaultMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy configures how a Client retries requests that were rate limited (429) or failed with a
+// server error (5xx). Retries are opt-in; pass a RetryPolicy in ClientOpts to enable them.
+//
+// Requests using an idempotent method (GET, HEAD, OPTIONS, PUT, DELETE) are retried on 429 and 5xx responses.
+// Other requests are only retried on 429, since Active Campaign rejects rate limited requests before processing them.
+// Requests with a body are only retried if the body can be rewound, which is always the case for requests built by
+// NewRequest.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseBackoff is the wait before the first retry. It doubles on each subsequent retry. Defaults to 500ms.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the computed exponential backoff. Defaults to 30s.
+	// A Retry-After header sent by Active Campaign is always honored, even if it exceeds MaxBackoff.
+	MaxBackoff time.Duration
+
+	// Jitter randomizes each computed backoff to between half and all of its value, so that concurrent
+	// clients do not retry in lockstep.
+	Jitter bool
+
+	// OnRetry, if set, is called before waiting for each retry.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a retry that is about to happen.
+type RetryEvent struct {
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+
+	// Wait is how long the client will wait before the next attempt.
+	Wait time.Duration
+
+	// Request is the request being retried.
+	Request *http.Request
+
+	// Response is the response that triggered the retry. Its body has already been closed.
+	Response *http.Response
+}
+
+// shouldRetry reports whether req should be attempted again after receiving resp on the given attempt.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch c := resp.StatusCode; {
+	case c == http.StatusTooManyRequests:
+		return true
+	case c >= 500 && c <= 599:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// backoff returns how long to wait after the given failed attempt.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return d
+	}
+
+	base, max := p.BaseBackoff, p.MaxBackoff
+	if base <= 0 {
+		base = defaultBaseBackoff
+	}
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	d := base
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	if p.Jitter {
+		half := d / 2
+		d = half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header of resp, which may be either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// send performs req once the rate limiter allows it, retrying it as configured by the Client's RetryPolicy.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil || !c.retry.shouldRetry(req, resp, attempt) {
+			return resp, err
+		}
+
+		// Drain the body so the underlying connection can be reused.
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		wait := c.retry.backoff(attempt, resp)
+		if c.retry.OnRetry != nil {
+			c.retry.OnRetry(RetryEvent{Attempt: attempt, Wait: wait, Request: req, Response: resp})
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package active_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_retriesRateLimited(t *testing.T) {
+	var events []RetryEvent
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{
+		RequestsPerSecond: -1,
+		Retry: &RetryPolicy{
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+			OnRetry:     func(e RetryEvent) { events = append(events, e) },
+		},
+	})
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"tag": {"tag": "t", "id": "1"}}`)
+	})
+
+	tag, _, err := c.Tags.Create(ctx, &CreateTagRequest{&Tag{Tag: "t"}})
+	if err != nil {
+		t.Fatalf("Tags.Create returned error: %v", err)
+	}
+	if tag.Tag.ID != "1" {
+		t.Errorf("Expected tag.Tag.ID = 1. Got tag.Tag.ID = %s", tag.Tag.ID)
+	}
+
+	if len(bodies) != 3 {
+		t.Fatalf("Expected 3 attempts. Got %d", len(bodies))
+	}
+	for i, b := range bodies {
+		if want := `{"tag":{"tag":"t"}}` + "\n"; b != want {
+			t.Errorf("Attempt %d body = %q, want %q", i+1, b, want)
+		}
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 retry events. Got %d", len(events))
+	}
+	for i, e := range events {
+		if e.Attempt != i+1 {
+			t.Errorf("RetryEvent.Attempt = %d, want %d", e.Attempt, i+1)
+		}
+		if e.Response.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("RetryEvent.Response.StatusCode = %d, want %d", e.Response.StatusCode, http.StatusTooManyRequests)
+		}
+	}
+	if events[0].Wait != time.Millisecond || events[1].Wait != 2*time.Millisecond {
+		t.Errorf("RetryEvent waits = %v, %v, want 1ms, 2ms", events[0].Wait, events[1].Wait)
+	}
+}
+
+func TestClient_Do_retryGivesUp(t *testing.T) {
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{
+		RequestsPerSecond: -1,
+		Retry:             &RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond},
+	})
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/3/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, resp, err := c.Tags.Retrieve(ctx, "1")
+	if err == nil {
+		t.Errorf("Expected error. Error is nil")
+	}
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected response with status code %d. Got %+v", http.StatusServiceUnavailable, resp)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts. Got %d", attempts)
+	}
+}
+
+func TestClient_Do_noRetryOnServerErrorForPost(t *testing.T) {
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{
+		RequestsPerSecond: -1,
+		Retry:             &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+	})
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, _, err := c.Tags.Create(ctx, &CreateTagRequest{&Tag{}})
+	if err == nil {
+		t.Errorf("Expected error. Error is nil")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt. Got %d", attempts)
+	}
+}
+
+func TestClient_Do_retryDisabledByDefault(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := c.Tags.ListAll(ctx, nil)
+	if !IsRateLimited(err) {
+		t.Errorf("Expected rate limited error. Got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt. Got %d", attempts)
+	}
+}
+
+func TestClient_Do_retryHonorsContext(t *testing.T) {
+	c, mux, _, teardown := setupWithOpts(&ClientOpts{
+		RequestsPerSecond: -1,
+		Retry:             &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Hour},
+	})
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	timeout, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := c.Tags.ListAll(timeout, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded. Got %v", err)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	empty := &http.Response{Header: http.Header{}}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{10, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt, empty); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+
+	p.Jitter = true
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(2, empty); got < time.Second || got > 2*time.Second {
+			t.Fatalf("backoff(2) with jitter = %v, want between 1s and 2s", got)
+		}
+	}
+}
+
+func TestRetryPolicy_backoffRetryAfter(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	seconds := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if got, want := p.backoff(1, seconds), 7*time.Second; got != want {
+		t.Errorf("backoff with Retry-After seconds = %v, want %v", got, want)
+	}
+
+	date := &http.Response{Header: http.Header{
+		"Retry-After": []string{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)},
+	}}
+	if got := p.backoff(1, date); got <= 55*time.Second || got > time.Minute {
+		t.Errorf("backoff with Retry-After date = %v, want about 1m", got)
+	}
+
+	invalid := &http.Response{Header: http.Header{"Retry-After": []string{"soon"}}}
+	if got, want := p.backoff(1, invalid), time.Second; got != want {
+		t.Errorf("backoff with invalid Retry-After = %v, want %v", got, want)
+	}
+}
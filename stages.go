@@ -0,0 +1,241 @@
+package active_campaign
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// StagesService handles communication with deal stage related
+// methods of the Active Campaign API.
+//
+// Active Campaign API docs: https://developers.activecampaign.com/reference#dealstage
+type StagesService service
+
+// Stage is a step of a pipeline. Deals in a pipeline are always in exactly one of its stages.
+type Stage struct {
+	Title string `json:"title,omitempty"`
+	// Group is the ID of the pipeline the stage belongs to.
+	Group string `json:"group,omitempty"`
+	// Order is the position of the stage in its pipeline, starting at 1.
+	Order string `json:"order,omitempty"`
+	// DealOrder sorts the deals shown in the stage, e.g. "title ASC" or "next-action DESC".
+	DealOrder   string `json:"dealOrder,omitempty"`
+	CardRegion1 string `json:"cardRegion1,omitempty"`
+	CardRegion2 string `json:"cardRegion2,omitempty"`
+	CardRegion3 string `json:"cardRegion3,omitempty"`
+	CardRegion4 string `json:"cardRegion4,omitempty"`
+	CardRegion5 string `json:"cardRegion5,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Width       string `json:"width,omitempty"`
+
+	// Read-only fields returned by Active Campaign.
+	Cdate string      `json:"cdate,omitempty"`
+	Udate string      `json:"udate,omitempty"`
+	Links *StageLinks `json:"links,omitempty"`
+	ID    string      `json:"id,omitempty"`
+}
+
+// StageLinks are the related resource URLs returned with a stage.
+type StageLinks struct {
+	Group string `json:"group"`
+}
+
+// CreateStageRequest is the request body used for creating a stage.
+type CreateStageRequest struct {
+	Stage *Stage `json:"dealStage"`
+}
+
+// UpdateStageRequest is the request body used for updating a stage.
+type UpdateStageRequest struct {
+	Stage *Stage `json:"dealStage"`
+}
+
+// StageResponse is the response body returned from creating, retrieving or updating a stage.
+type StageResponse struct {
+	Stage *Stage `json:"dealStage"`
+}
+
+// StageOptions specifies the optional parameters to StagesService.Create and StagesService.Update.
+type StageOptions struct {
+	// Reorder shifts the other stages of the pipeline to make room for the stage's Order.
+	Reorder bool `url:"reorder,omitempty"`
+}
+
+// ListStagesOptions specifies the optional parameters to StagesService.List.
+type ListStagesOptions struct {
+	ListOptions
+
+	// Title filters stages whose title contains the given value.
+	Title string `url:"filters[title],omitempty"`
+	// PipelineID filters stages by the pipeline they belong to.
+	PipelineID string `url:"filters[d_groupid],omitempty"`
+}
+
+// ListStagesResponse is the response body returned from listing stages.
+type ListStagesResponse struct {
+	Stages []*Stage `json:"dealStages"`
+	Meta   *Meta    `json:"meta"`
+}
+
+// Create a stage.
+func (s *StagesService) Create(ctx context.Context, stage *CreateStageRequest, opts *StageOptions) (*StageResponse, *Response, error) {
+	u, err := addOptions("dealStages", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, u, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &StageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Retrieve a stage.
+func (s *StagesService) Retrieve(ctx context.Context, id string) (*StageResponse, *Response, error) {
+	u := "dealStages/" + id
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &StageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Update a stage.
+func (s *StagesService) Update(ctx context.Context, id string, stage *UpdateStageRequest, opts *StageOptions) (*StageResponse, *Response, error) {
+	u, err := addOptions("dealStages/"+id, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPut, u, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &StageResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a stage. Active Campaign refuses to delete a stage that still holds deals; use DeleteMovingDeals
+// to move them to another stage first.
+func (s *StagesService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "dealStages/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// List stages, one page at a time. Use ListAllPages to walk every page.
+func (s *StagesService) List(ctx context.Context, opts *ListStagesOptions) (*ListStagesResponse, *Response, error) {
+	u, err := addOptions("dealStages", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListStagesResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// MoveDealsRequest is the request body used for moving the deals of a stage to another stage.
+// Only the Stage of Deal is used.
+type MoveDealsRequest struct {
+	Deal *Deal `json:"deal"`
+}
+
+// MoveDeals moves every deal of a stage to the stage with ID toStageID.
+func (s *StagesService) MoveDeals(ctx context.Context, id, toStageID string) (*Response, error) {
+	u := "dealStages/" + id + "/deals"
+	body := &MoveDealsRequest{Deal: &Deal{Stage: toStageID}}
+	req, err := s.client.NewRequest(http.MethodPut, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.doNoContent(ctx, req)
+}
+
+// DeleteMovingDeals moves every deal of a stage to the stage with ID toStageID, then deletes the stage.
+func (s *StagesService) DeleteMovingDeals(ctx context.Context, id, toStageID string) (*Response, error) {
+	resp, err := s.MoveDeals(ctx, id, toStageID)
+	if err != nil {
+		return resp, err
+	}
+
+	return s.Delete(ctx, id)
+}
+
+// Reorder sets the order of the stages of a pipeline to the order of stageIDs.
+func (s *StagesService) Reorder(ctx context.Context, stageIDs []string) error {
+	for i, id := range stageIDs {
+		stage := &UpdateStageRequest{Stage: &Stage{Order: strconv.Itoa(i + 1)}}
+		if _, _, err := s.Update(ctx, id, stage, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByTitle returns the stage of a pipeline whose title matches the given one case-insensitively,
+// or nil if there is none.
+func (s *StagesService) FindByTitle(ctx context.Context, pipelineID, title string) (*Stage, error) {
+	var found *Stage
+	opts := &ListStagesOptions{ListOptions: ListOptions{Limit: 100}, Title: title, PipelineID: pipelineID}
+	err := ListAllPages(ctx, &opts.ListOptions, func(*ListOptions) (*Response, error) {
+		stages, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, st := range stages.Stages {
+			if strings.EqualFold(st.Title, title) {
+				found = st
+				return nil, nil
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
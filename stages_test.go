@@ -0,0 +1,271 @@
+package active_campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStagesService_Create(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateStageRequest{
+		&Stage{
+			Title:     "Negotiation",
+			Group:     "2",
+			Order:     "2",
+			DealOrder: "title ASC",
+			Color:     "32B0FC",
+			Width:     "280",
+		},
+	}
+
+	mux.HandleFunc("/api/3/dealStages", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateStageRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{"reorder": "1"})
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealStage": {
+					"title": "Negotiation",
+					"group": "2",
+					"order": "2",
+					"dealOrder": "title ASC",
+					"color": "32B0FC",
+					"width": "280",
+					"cdate": "2020-06-24T12:00:00-05:00",
+					"links": {
+						"group": "https://your_base_url.api-us1.com/api/3/dealStages/6/group"
+					},
+					"id": "6"
+				}
+			}`)
+	})
+
+	stage, _, err := c.Stages.Create(ctx, input, &StageOptions{Reorder: true})
+	if err != nil {
+		t.Fatalf("Stages.Create returned error: %v", err)
+	}
+
+	want := &StageResponse{
+		Stage: &Stage{
+			Title:     "Negotiation",
+			Group:     "2",
+			Order:     "2",
+			DealOrder: "title ASC",
+			Color:     "32B0FC",
+			Width:     "280",
+			Cdate:     "2020-06-24T12:00:00-05:00",
+			Links:     &StageLinks{Group: "https://your_base_url.api-us1.com/api/3/dealStages/6/group"},
+			ID:        "6",
+		},
+	}
+	if !reflect.DeepEqual(stage, want) {
+		t.Errorf("Stages.Create returned %+v, want %+v", stage, want)
+	}
+}
+
+func TestStagesService_Retrieve(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealStages/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"dealStage": {"title": "Negotiation", "group": "2", "order": "2", "id": "6"}}`)
+	})
+
+	stage, _, err := c.Stages.Retrieve(ctx, "6")
+	if err != nil {
+		t.Fatalf("Stages.Retrieve returned error: %v", err)
+	}
+
+	want := &StageResponse{Stage: &Stage{Title: "Negotiation", Group: "2", Order: "2", ID: "6"}}
+	if !reflect.DeepEqual(stage, want) {
+		t.Errorf("Stages.Retrieve returned %+v, want %+v", stage, want)
+	}
+}
+
+func TestStagesService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateStageRequest{&Stage{Title: "Negotiating"}}
+
+	mux.HandleFunc("/api/3/dealStages/6", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateStageRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		testFormValues(t, r, values{})
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"dealStage": {"title": "Negotiating", "id": "6"}}`)
+	})
+
+	stage, _, err := c.Stages.Update(ctx, "6", input, nil)
+	if err != nil {
+		t.Fatalf("Stages.Update returned error: %v", err)
+	}
+
+	want := &StageResponse{Stage: &Stage{Title: "Negotiating", ID: "6"}}
+	if !reflect.DeepEqual(stage, want) {
+		t.Errorf("Stages.Update returned %+v, want %+v", stage, want)
+	}
+}
+
+func TestStagesService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealStages/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Stages.Delete(ctx, "6")
+	if err != nil {
+		t.Errorf("Stages.Delete returned error: %v", err)
+	}
+}
+
+func TestStagesService_List(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealStages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filters[d_groupid]": "2", "filters[title]": "Nego"})
+		_, _ = fmt.Fprint(w, `{"dealStages": [{"title": "Negotiation", "group": "2", "id": "6"}], "meta": {"total": "1"}}`)
+	})
+
+	stages, _, err := c.Stages.List(ctx, &ListStagesOptions{PipelineID: "2", Title: "Nego"})
+	if err != nil {
+		t.Fatalf("Stages.List returned error: %v", err)
+	}
+
+	want := &ListStagesResponse{
+		Stages: []*Stage{{Title: "Negotiation", Group: "2", ID: "6"}},
+		Meta:   &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(stages, want) {
+		t.Errorf("Stages.List returned %+v, want %+v", stages, want)
+	}
+}
+
+func TestStagesService_DeleteMovingDeals(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/api/3/dealStages/6/deals", func(w http.ResponseWriter, r *http.Request) {
+		v := new(MoveDealsRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		want := &MoveDealsRequest{Deal: &Deal{Stage: "4"}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		calls = append(calls, "move")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/api/3/dealStages/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "delete")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Stages.DeleteMovingDeals(ctx, "6", "4")
+	if err != nil {
+		t.Fatalf("Stages.DeleteMovingDeals returned error: %v", err)
+	}
+
+	if want := []string{"move", "delete"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("Stages.DeleteMovingDeals made calls %v, want %v", calls, want)
+	}
+}
+
+func TestStagesService_DeleteMovingDeals_moveFails(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealStages/6/deals", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"message": "No Result found for Stage with id 4"}`)
+	})
+	mux.HandleFunc("/api/3/dealStages/6", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Stages.DeleteMovingDeals deleted the stage after failing to move its deals")
+	})
+
+	_, err := c.Stages.DeleteMovingDeals(ctx, "6", "4")
+	if !IsNotFound(err) {
+		t.Errorf("Stages.DeleteMovingDeals returned %v, want a not found error", err)
+	}
+}
+
+func TestStagesService_Reorder(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	orders := map[string]string{}
+	for _, id := range []string{"4", "5", "6"} {
+		id := id
+		mux.HandleFunc("/api/3/dealStages/"+id, func(w http.ResponseWriter, r *http.Request) {
+			v := new(UpdateStageRequest)
+			_ = json.NewDecoder(r.Body).Decode(v)
+
+			testMethod(t, r, "PUT")
+			orders[id] = v.Stage.Order
+			_, _ = fmt.Fprintf(w, `{"dealStage": {"order": %q, "id": %q}}`, v.Stage.Order, id)
+		})
+	}
+
+	err := c.Stages.Reorder(ctx, []string{"6", "4", "5"})
+	if err != nil {
+		t.Fatalf("Stages.Reorder returned error: %v", err)
+	}
+
+	want := map[string]string{"6": "1", "4": "2", "5": "3"}
+	if !reflect.DeepEqual(orders, want) {
+		t.Errorf("Stages.Reorder set orders %v, want %v", orders, want)
+	}
+}
+
+func TestStagesService_FindByTitle(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/dealStages", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"filters[d_groupid]": "2", "filters[title]": "won", "limit": "100"})
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"dealStages": [
+					{"title": "Almost Won", "group": "2", "id": "7"},
+					{"title": "Won", "group": "2", "id": "8"}
+				],
+				"meta": {"total": "2"}
+			}`)
+	})
+
+	stage, err := c.Stages.FindByTitle(ctx, "2", "won")
+	if err != nil {
+		t.Fatalf("Stages.FindByTitle returned error: %v", err)
+	}
+	if stage == nil || stage.ID != "8" {
+		t.Errorf("Stages.FindByTitle returned %+v, want stage 8", stage)
+	}
+}
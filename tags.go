@@ -1,6 +1,13 @@
 package active_campaign
 
-import "net/http"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
 
 // TagsService handles communication with tag related
 // methods of the Active Campaign API.
@@ -8,6 +15,12 @@ import "net/http"
 // Active Campaign API docs: https://developers.activecampaign.com/reference#tags
 type TagsService service
 
+// Tag types supported by Active Campaign.
+const (
+	TagTypeContact  = "contact"
+	TagTypeTemplate = "template"
+)
+
 // Tags are labels that you can apply to contacts to help you organize them.
 // The API enables you to add, view, update, and delete tags.
 type Tag struct {
@@ -42,11 +55,23 @@ type TagResponse struct {
 	Tag *CreatedTag `json:"tag"`
 }
 
-// Meta is embedded in the ListAllResponse struct.
+// Meta is embedded in list response structs.
 type Meta struct {
 	Total string `json:"total"`
 }
 
+// UnmarshalJSON decodes meta, accepting a total sent as either a string or a number.
+func (m *Meta) UnmarshalJSON(data []byte) error {
+	var meta struct {
+		Total json.Number `json:"total"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+	m.Total = meta.Total.String()
+	return nil
+}
+
 // ListAllResponse is the response body returned from listing all tags.
 type ListAllResponse struct {
 	Tags []*CreatedTag `json:"tags"`
@@ -54,7 +79,7 @@ type ListAllResponse struct {
 }
 
 // Create a tag.
-func (s *TagsService) Create(tag *CreateTagRequest) (*TagResponse, *Response, error) {
+func (s *TagsService) Create(ctx context.Context, tag *CreateTagRequest) (*TagResponse, *Response, error) {
 	u := "tags"
 	req, err := s.client.NewRequest(http.MethodPost, u, tag)
 	if err != nil {
@@ -62,7 +87,7 @@ func (s *TagsService) Create(tag *CreateTagRequest) (*TagResponse, *Response, er
 	}
 
 	c := &TagResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -72,7 +97,7 @@ func (s *TagsService) Create(tag *CreateTagRequest) (*TagResponse, *Response, er
 }
 
 // Retrieve a tag.
-func (s *TagsService) Retrieve(id string) (*TagResponse, *Response, error) {
+func (s *TagsService) Retrieve(ctx context.Context, id string) (*TagResponse, *Response, error) {
 	u := "tags/" + id
 	req, err := s.client.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
@@ -80,7 +105,7 @@ func (s *TagsService) Retrieve(id string) (*TagResponse, *Response, error) {
 	}
 
 	c := &TagResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -89,16 +114,78 @@ func (s *TagsService) Retrieve(id string) (*TagResponse, *Response, error) {
 	return c, resp, nil
 }
 
-// Lists all tags.
-func (s *TagsService) ListAll() (*ListAllResponse, *Response, error) {
-	u := "tags"
+// Lists all tags, one page at a time. Use ListAllPages to walk every page.
+func (s *TagsService) ListAll(ctx context.Context, opts *ListOptions) (*ListAllResponse, *Response, error) {
+	u, err := addOptions("tags", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &ListAllResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// UpdateTagRequest is the request body used for updating a tag.
+type UpdateTagRequest struct {
+	Tag *Tag `json:"tag"`
+}
+
+// Update a tag.
+func (s *TagsService) Update(ctx context.Context, id string, tag *UpdateTagRequest) (*TagResponse, *Response, error) {
+	u := "tags/" + id
+	req, err := s.client.NewRequest(http.MethodPut, u, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &TagResponse{}
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return c, resp, nil
+}
+
+// Delete a tag.
+func (s *TagsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := "tags/" + id
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.client.cache.forgetTag(id)
+	return s.client.doNoContent(ctx, req)
+}
+
+// Search lists tags whose name contains term, one page at a time. Active Campaign's search is fuzzy,
+// so use FindOrCreate to resolve a tag name exactly.
+func (s *TagsService) Search(ctx context.Context, term string, opts *ListOptions) (*ListAllResponse, *Response, error) {
+	u, err := addOptions("tags?search="+url.QueryEscape(term), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	c := &ListAllResponse{}
-	resp, err := s.client.Do(req, c)
+	resp, err := s.client.Do(ctx, req, c)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -106,3 +193,72 @@ func (s *TagsService) ListAll() (*ListAllResponse, *Response, error) {
 
 	return c, resp, nil
 }
+
+// FindOrCreate returns the tag with exactly the given name and type, creating it if it does not exist yet.
+func (s *TagsService) FindOrCreate(ctx context.Context, name, tagType string) (*CreatedTag, error) {
+	tag, err := s.find(ctx, name, tagType)
+	if err != nil || tag != nil {
+		return tag, err
+	}
+
+	created, _, err := s.Create(ctx, &CreateTagRequest{&Tag{Tag: name, TagType: tagType}})
+	if IsDuplicate(err) {
+		// Somebody else created the tag since we searched for it.
+		tag, findErr := s.find(ctx, name, tagType)
+		if findErr != nil {
+			return nil, findErr
+		}
+		if tag == nil {
+			// The existing tag differs only in case, or search has not indexed it yet.
+			return nil, fmt.Errorf("tag %q already exists but could not be found: %w", name, err)
+		}
+		return tag, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return created.Tag, nil
+}
+
+// find searches every page of tags matching name and returns the one with exactly that name and type,
+// or nil if there is none.
+func (s *TagsService) find(ctx context.Context, name, tagType string) (*CreatedTag, error) {
+	var found *CreatedTag
+	err := ListAllPages(ctx, &ListOptions{Limit: 100}, func(opts *ListOptions) (*Response, error) {
+		tags, resp, err := s.Search(ctx, name, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, t := range tags.Tags {
+			if t.Tag == name && strings.EqualFold(t.TagType, tagType) {
+				found = t
+				return nil, nil
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// resolveID returns the ID of the contact tag with the given name, creating the tag if needed.
+func (s *TagsService) resolveID(ctx context.Context, name string) (string, error) {
+	if id, ok := s.client.cache.tagID(name); ok {
+		return id, nil
+	}
+
+	tag, err := s.FindOrCreate(ctx, name, TagTypeContact)
+	if err != nil {
+		return "", err
+	}
+	if tag == nil || tag.ID == "" {
+		return "", fmt.Errorf("tag %q could not be resolved to an ID", name)
+	}
+
+	s.client.cache.setTagID(name, tag.ID)
+	return tag.ID, nil
+}
@@ -50,7 +50,7 @@ func TestTagService_Create(t *testing.T) {
 				}
 			}`)
 	})
-	tag, _, err := c.Tags.Create(input)
+	tag, _, err := c.Tags.Create(ctx, input)
 	if err != nil {
 		t.Errorf("Tags.Create returned error: %v", err)
 	}
@@ -107,7 +107,7 @@ func TestTagService_Create_EmptyTag(t *testing.T) {
 				}
 			}`)
 	})
-	tag, _, err := c.Tags.Create(input)
+	tag, _, err := c.Tags.Create(ctx, input)
 	if err != nil {
 		t.Errorf("Tags.Create returned error: %v", err)
 	}
@@ -140,7 +140,7 @@ func TestTagService_Create_DoError(t *testing.T) {
 		&Tag{},
 	}
 
-	_, resp, err := c.Tags.Create(input)
+	_, resp, err := c.Tags.Create(ctx, input)
 	if err == nil {
 		t.Errorf("Expected error. Error is nil")
 	}
@@ -175,7 +175,7 @@ func TestTagService_Retrieve(t *testing.T) {
 				}
 			}`)
 	})
-	tag, _, err := c.Tags.Retrieve("1")
+	tag, _, err := c.Tags.Retrieve(ctx, "1")
 	if err != nil {
 		t.Errorf("Tags.Retrieve returned error: %v", err)
 	}
@@ -197,7 +197,7 @@ func TestTagService_Retrieve_DoError(t *testing.T) {
 		w.WriteHeader(http.StatusBadRequest)
 	})
 
-	_, resp, err := c.Tags.Retrieve("1")
+	_, resp, err := c.Tags.Retrieve(ctx, "1")
 	if err == nil {
 		t.Errorf("Expected error. Error is nil")
 	}
@@ -219,7 +219,7 @@ func TestTagService_Retrieve_NotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	_, resp, err := c.Tags.Retrieve("1")
+	_, resp, err := c.Tags.Retrieve(ctx, "1")
 	if err == nil {
 		t.Errorf("Expected error. Error is nil")
 	}
@@ -270,7 +270,7 @@ func TestTagService_ListAll(t *testing.T) {
 				}
 			}`)
 	})
-	tags, _, err := c.Tags.ListAll()
+	tags, _, err := c.Tags.ListAll(ctx, nil)
 	if err != nil {
 		t.Errorf("Tags.ListAll returned error: %v", err)
 	}
@@ -295,7 +295,7 @@ func TestTagService_ListAll_DoError(t *testing.T) {
 		w.WriteHeader(http.StatusBadRequest)
 	})
 
-	_, resp, err := c.Tags.ListAll()
+	_, resp, err := c.Tags.ListAll(ctx, nil)
 	if err == nil {
 		t.Errorf("Expected error. Error is nil")
 	}
@@ -306,3 +306,177 @@ func TestTagService_ListAll_DoError(t *testing.T) {
 		t.Errorf("Expected status code %d. Got %d", http.StatusBadRequest, resp.StatusCode)
 	}
 }
+
+func TestTagService_Update(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateTagRequest{&Tag{Tag: "Renamed", TagType: "contact"}}
+
+	mux.HandleFunc("/api/3/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateTagRequest)
+		_ = json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"tag": {"tag": "Renamed", "tagType": "contact", "id": "1"}}`)
+	})
+
+	tag, _, err := c.Tags.Update(ctx, "1", input)
+	if err != nil {
+		t.Fatalf("Tags.Update returned error: %v", err)
+	}
+
+	want := &TagResponse{&CreatedTag{Tag: "Renamed", TagType: "contact", ID: "1"}}
+	if !reflect.DeepEqual(tag, want) {
+		t.Errorf("Tags.Update returned %+v, want %+v", tag, want)
+	}
+}
+
+func TestTagService_Delete(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	_, err := c.Tags.Delete(ctx, "1")
+	if err != nil {
+		t.Errorf("Tags.Delete returned error: %v", err)
+	}
+}
+
+func TestTagService_Search(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"search": "vip & co", "limit": "5"})
+		_, _ = fmt.Fprint(w, `{"tags": [{"tag": "vip & co", "tagType": "contact", "id": "1"}], "meta": {"total": "1"}}`)
+	})
+
+	tags, _, err := c.Tags.Search(ctx, "vip & co", &ListOptions{Limit: 5})
+	if err != nil {
+		t.Fatalf("Tags.Search returned error: %v", err)
+	}
+
+	want := &ListAllResponse{
+		Tags: []*CreatedTag{{Tag: "vip & co", TagType: "contact", ID: "1"}},
+		Meta: &Meta{Total: "1"},
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("Tags.Search returned %+v, want %+v", tags, want)
+	}
+}
+
+func TestTagService_FindOrCreate_found(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w,
+			`
+			{
+				"tags": [
+					{"tag": "vip-gold", "tagType": "contact", "id": "1"},
+					{"tag": "vip", "tagType": "template", "id": "2"},
+					{"tag": "vip", "tagType": "contact", "id": "3"}
+				],
+				"meta": {"total": "3"}
+			}`)
+	})
+
+	tag, err := c.Tags.FindOrCreate(ctx, "vip", TagTypeContact)
+	if err != nil {
+		t.Fatalf("Tags.FindOrCreate returned error: %v", err)
+	}
+	if tag.ID != "3" {
+		t.Errorf("Tags.FindOrCreate returned tag %s, want 3", tag.ID)
+	}
+}
+
+func TestTagService_FindOrCreate_created(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprint(w, `{"tags": [{"tag": "vip-gold", "tagType": "contact", "id": "1"}], "meta": {"total": "1"}}`)
+		case http.MethodPost:
+			v := new(CreateTagRequest)
+			_ = json.NewDecoder(r.Body).Decode(v)
+			if want := (&Tag{Tag: "vip", TagType: "contact"}); !reflect.DeepEqual(v.Tag, want) {
+				t.Errorf("Request body = %+v, want %+v", v.Tag, want)
+			}
+			_, _ = fmt.Fprint(w, `{"tag": {"tag": "vip", "tagType": "contact", "id": "2"}}`)
+		}
+	})
+
+	tag, err := c.Tags.FindOrCreate(ctx, "vip", TagTypeContact)
+	if err != nil {
+		t.Fatalf("Tags.FindOrCreate returned error: %v", err)
+	}
+	if tag.ID != "2" {
+		t.Errorf("Tags.FindOrCreate returned tag %s, want 2", tag.ID)
+	}
+}
+
+func TestTagService_FindOrCreate_createdConcurrently(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	searches := 0
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			searches++
+			if searches == 1 {
+				_, _ = fmt.Fprint(w, `{"tags": [], "meta": {"total": "0"}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"tags": [{"tag": "vip", "tagType": "contact", "id": "4"}], "meta": {"total": "1"}}`)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"errors": [{"title": "Duplicate entry", "code": "duplicate"}]}`)
+		}
+	})
+
+	tag, err := c.Tags.FindOrCreate(ctx, "vip", TagTypeContact)
+	if err != nil {
+		t.Fatalf("Tags.FindOrCreate returned error: %v", err)
+	}
+	if tag.ID != "4" {
+		t.Errorf("Tags.FindOrCreate returned tag %s, want 4", tag.ID)
+	}
+}
+
+func TestTagService_FindOrCreate_duplicateNotFound(t *testing.T) {
+	c, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/3/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprint(w, `{"tags": [{"tag": "VIP", "tagType": "contact", "id": "4"}], "meta": {"total": "1"}}`)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"errors": [{"title": "Duplicate entry", "code": "duplicate"}]}`)
+		}
+	})
+
+	tag, err := c.Tags.FindOrCreate(ctx, "vip", TagTypeContact)
+	if !IsDuplicate(err) {
+		t.Errorf("Tags.FindOrCreate returned error %v, want a duplicate error", err)
+	}
+	if tag != nil {
+		t.Errorf("Tags.FindOrCreate returned tag %+v, want nil", tag)
+	}
+}